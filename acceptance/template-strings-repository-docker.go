@@ -41,6 +41,9 @@ resource "nexus_repository_docker_proxy" "acceptance" {
 {{- end }}
 {{- if .Docker.HTTPSPort }}
 		https_port = "{{ .Docker.HTTPSPort }}"
+{{- end }}
+{{- if .Docker.Subdomain }}
+		subdomain = "{{ .Docker.Subdomain }}"
 {{- end }}
 		v1_enabled = "{{ .Docker.V1Enabled }}"
 	}
@@ -49,6 +52,16 @@ resource "nexus_repository_docker_proxy" "acceptance" {
 		index_type = "{{ .DockerProxy.IndexType }}"
 {{- if .DockerProxy.IndexURL }}
 		index_url = "{{ .DockerProxy.IndexURL }}"
+{{- end }}
+{{- if .DockerProxy.CacheForeignLayers }}
+		cache_foreign_layers = {{ .DockerProxy.CacheForeignLayers }}
+{{- end }}
+{{- if .DockerProxy.ForeignLayerURLWhitelist }}
+		foreign_layer_url_whitelist = [
+		{{- range $val := .DockerProxy.ForeignLayerURLWhitelist }}
+			"{{ $val }}",
+		{{ end -}}
+		]
 {{- end }}
 	}
 ` + TemplateStringProxyRepository
@@ -1,6 +1,26 @@
 package acceptance
 
 const (
+	TemplateStringRepositoryMavenGroup = `
+resource "nexus_repository_maven_group" "acceptance" {
+	depends_on = [
+		nexus_repository_maven_hosted.acceptance
+	]
+{{- if .Maven }}
+	maven {
+{{- if .Maven.VersionPolicy }}
+		version_policy = "{{ .Maven.VersionPolicy }}"
+{{- end }}
+{{- if .Maven.LayoutPolicy }}
+		layout_policy = "{{ .Maven.LayoutPolicy }}"
+{{- end }}
+{{- if .Maven.ContentDisposition }}
+		content_disposition = "{{ .Maven.ContentDisposition }}"
+{{- end }}
+	}
+{{- end }}
+` + TemplateStringGroupRepository
+
 	TemplateStringRepositoryMavenHosted = `
 resource "nexus_repository_maven_hosted" "acceptance" {
 	maven {
@@ -15,4 +35,19 @@ resource "nexus_repository_maven_hosted" "acceptance" {
 {{- end }}
 	}
 ` + TemplateStringHostedRepository
+
+	TemplateStringRepositoryMavenProxy = `
+resource "nexus_repository_maven_proxy" "acceptance" {
+	maven {
+{{- if .Maven.VersionPolicy }}
+		version_policy = "{{ .Maven.VersionPolicy }}"
+{{- end }}
+{{- if .Maven.LayoutPolicy }}
+		layout_policy = "{{ .Maven.LayoutPolicy }}"
+{{- end }}
+{{- if .Maven.ContentDisposition }}
+		content_disposition = "{{ .Maven.ContentDisposition }}"
+{{- end }}
+	}
+` + TemplateStringProxyRepository
 )
@@ -0,0 +1,17 @@
+package acceptance
+
+const (
+	TemplateStringRepositoryNpmHosted = `
+resource "nexus_repository_npm_hosted" "acceptance" {
+` + TemplateStringHostedRepository
+
+	TemplateStringRepositoryNpmProxy = `
+resource "nexus_repository_npm_proxy" "acceptance" {
+{{- if .Npm }}
+	npm {
+		remove_non_cataloged = {{ .Npm.RemoveNonCataloged }}
+		remove_quarantined   = {{ .Npm.RemoveQuarantined }}
+	}
+{{- end }}
+` + TemplateStringProxyRepository
+)
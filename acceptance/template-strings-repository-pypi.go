@@ -0,0 +1,11 @@
+package acceptance
+
+const (
+	TemplateStringRepositoryPypiHosted = `
+resource "nexus_repository_pypi_hosted" "acceptance" {
+` + TemplateStringHostedRepository
+
+	TemplateStringRepositoryPypiProxy = `
+resource "nexus_repository_pypi_proxy" "acceptance" {
+` + TemplateStringProxyRepository
+)
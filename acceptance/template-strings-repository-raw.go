@@ -0,0 +1,21 @@
+package acceptance
+
+const (
+	TemplateStringRepositoryRawHosted = `
+resource "nexus_repository_raw_hosted" "acceptance" {
+{{- if .Raw }}
+	raw {
+		content_disposition = "{{ .Raw.ContentDisposition }}"
+	}
+{{- end }}
+` + TemplateStringHostedRepository
+
+	TemplateStringRepositoryRawProxy = `
+resource "nexus_repository_raw_proxy" "acceptance" {
+{{- if .Raw }}
+	raw {
+		content_disposition = "{{ .Raw.ContentDisposition }}"
+	}
+{{- end }}
+` + TemplateStringProxyRepository
+)
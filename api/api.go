@@ -0,0 +1,154 @@
+// Package api implements calls to the Nexus REST API which are not (yet)
+// covered by go-nexus-client. The requests are built by the low level client
+// of a go-nexus-client instance, so URL and credentials are shared with the
+// regular services, and sent with the *http.Client of the Client.
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+// Client is a go-nexus-client instance together with the *http.Client the
+// requests of this package are sent with and the settings of the provider
+type Client struct {
+	*nexus.NexusClient
+
+	httpClient *http.Client
+	ctx        context.Context
+
+	// shared by the copies made by WithContext
+	settings *clientSettings
+}
+
+type clientSettings struct {
+	// the *http.Client of the client WithContext copies were made of
+	httpClient *http.Client
+
+	retries       int
+	requestLimit  *RequestLimit
+	serverVersion serverVersionEntry
+}
+
+// service is the base of the services of this package
+type service struct {
+	Client *Client
+}
+
+// NewHTTPClient returns an *http.Client which is configured like the one
+// go-nexus-client uses for its services
+func NewHTTPClient(config client.Config) *http.Client {
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: config.Insecure,
+			},
+		},
+	}
+}
+
+// NewClient returns a client for the given configuration. The requests of
+// this package are sent with httpClient, e.g. to set headers or to limit the
+// number of concurrent requests, a nil httpClient is replaced by
+// NewHTTPClient(config). go-nexus-client does not allow to replace the
+// *http.Client of its own services, so their requests are not affected.
+func NewClient(config client.Config, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = NewHTTPClient(config)
+	}
+	return &Client{
+		NexusClient: nexus.NewClient(config),
+		httpClient:  httpClient,
+		ctx:         context.Background(),
+		settings:    &clientSettings{httpClient: httpClient, retries: DefaultRetries},
+	}
+}
+
+// LowLevelClient returns the HTTP client which is shared by all services of
+// the given go-nexus-client instance
+func LowLevelClient(nexusClient *nexus.NexusClient) *client.Client {
+	return nexusClient.RoutingRule.Client
+}
+
+// execute sends a request built by the low level client of c with the
+// *http.Client of c
+func execute(c *Client, method string, endpoint string, payload io.Reader) ([]byte, *http.Response, error) {
+	req, err := LowLevelClient(c.NexusClient).NewRequest(method, endpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	return body, resp, err
+}
+
+// BaseURL returns the URL of the Nexus server the given client is
+// configured for
+func BaseURL(c *Client) (*url.URL, error) {
+	// go-nexus-client appends the endpoint to the configured URL
+	req, err := LowLevelClient(c.NexusClient).NewRequest(http.MethodGet, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	return url.Parse(strings.TrimSuffix(req.URL.String(), "/"))
+}
+
+// WithContext returns a copy of the given client whose requests of this
+// package are bound to ctx, so they are canceled once ctx is done.
+// go-nexus-client does not accept a context itself, see Context. The copy
+// shares the go-nexus-client instance, the connections and the settings of
+// the original client.
+func WithContext(ctx context.Context, c *Client) *Client {
+	contextClient := *c
+	contextClient.httpClient = &http.Client{
+		Timeout:   c.httpClient.Timeout,
+		Transport: &contextTransport{ctx: ctx, next: c.httpClient.Transport},
+	}
+	contextClient.ctx = ctx
+	return &contextClient
+}
+
+// Context returns the context a copy made by WithContext is bound to. Code
+// which waits between requests of go-nexus-client, e.g. to retry them,
+// should stop once it is done.
+func Context(c *Client) context.Context {
+	return c.ctx
+}
+
+// withoutContext returns the client a copy made by WithContext was made of
+func withoutContext(c *Client) *Client {
+	originalClient := *c
+	originalClient.httpClient = c.settings.httpClient
+	originalClient.ctx = context.Background()
+	return &originalClient
+}
+
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return next.RoundTrip(req.WithContext(t.ctx))
+}
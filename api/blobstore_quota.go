@@ -0,0 +1,44 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+)
+
+const (
+	blobstoresAPIEndpoint = client.BasePath + "v1/blobstores"
+)
+
+type BlobstoreQuotaService service
+
+func NewBlobstoreQuotaService(nexusClient *Client) *BlobstoreQuotaService {
+	return &BlobstoreQuotaService{
+		Client: nexusClient,
+	}
+}
+
+// GetStatus returns whether the blobstore currently violates its soft quota.
+// Nexus versions without the quota status API return nil.
+func (s *BlobstoreQuotaService) GetStatus(name string) (*blobstore.QuotaStatus, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s/%s/quota-status", blobstoresAPIEndpoint, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get quota status of blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var status blobstore.QuotaStatus
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("could not unmarshal quota status of blobstore '%s': %v", name, err)
+	}
+	return &status, nil
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"fmt"
+)
+
+const (
+	capabilityExtDirectAction = "capability_Capability"
+)
+
+// Capability is a Nexus capability, e.g. the base URL or outreach configuration
+type Capability struct {
+	ID         string            `json:"id,omitempty"`
+	TypeID     string            `json:"typeId"`
+	Enabled    bool              `json:"enabled"`
+	Notes      string            `json:"notes,omitempty"`
+	Properties map[string]string `json:"properties"`
+}
+
+// CapabilityService manages capabilities. Nexus only exposes them through the
+// ExtDirect API of the UI.
+type CapabilityService service
+
+func NewCapabilityService(nexusClient *Client) *CapabilityService {
+	return &CapabilityService{
+		Client: nexusClient,
+	}
+}
+
+func (s *CapabilityService) List() ([]Capability, error) {
+	var capabilities []Capability
+	if err := extDirectCall(s.Client, capabilityExtDirectAction, "read", nil, &capabilities); err != nil {
+		return nil, fmt.Errorf("could not list capabilities: %w", err)
+	}
+	return capabilities, nil
+}
+
+// GetByType returns the first capability of the given type or nil if there is none
+func (s *CapabilityService) GetByType(typeID string) (*Capability, error) {
+	capabilities, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, capability := range capabilities {
+		if capability.TypeID == typeID {
+			return &capability, nil
+		}
+	}
+	return nil, nil
+}
+
+// Create creates the capability and returns it including its ID
+func (s *CapabilityService) Create(capability Capability) (*Capability, error) {
+	var created Capability
+	if err := extDirectCall(s.Client, capabilityExtDirectAction, "create", []interface{}{capability}, &created); err != nil {
+		return nil, fmt.Errorf("could not create capability '%s': %w", capability.TypeID, err)
+	}
+	return &created, nil
+}
+
+func (s *CapabilityService) Update(capability Capability) error {
+	if err := extDirectCall(s.Client, capabilityExtDirectAction, "update", []interface{}{capability}, nil); err != nil {
+		return fmt.Errorf("could not update capability '%s': %w", capability.ID, err)
+	}
+	return nil
+}
+
+func (s *CapabilityService) Delete(id string) error {
+	if err := extDirectCall(s.Client, capabilityExtDirectAction, "remove", []interface{}{id}, nil); err != nil {
+		return fmt.Errorf("could not delete capability '%s': %w", id, err)
+	}
+	return nil
+}
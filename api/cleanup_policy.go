@@ -0,0 +1,91 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	cleanupPolicyExtDirectAction = "cleanup_CleanupPolicy"
+)
+
+// CleanupPolicy is a cleanup policy as returned by the ExtDirect API
+type CleanupPolicy struct {
+	Name                    string `json:"name"`
+	Format                  string `json:"format"`
+	Notes                   string `json:"notes,omitempty"`
+	CriteriaLastBlobUpdated *int   `json:"criteriaLastBlobUpdated,omitempty"`
+	CriteriaLastDownloaded  *int   `json:"criteriaLastDownloaded,omitempty"`
+	CriteriaReleaseType     string `json:"criteriaReleaseType,omitempty"`
+	CriteriaAssetRegex      string `json:"criteriaAssetRegex,omitempty"`
+}
+
+// CleanupPreviewComponent is a component which a cleanup policy would delete
+type CleanupPreviewComponent struct {
+	RepositoryName string `json:"repositoryName"`
+	Group          string `json:"group"`
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+}
+
+type extDirectFilter struct {
+	Property string `json:"property"`
+	Value    string `json:"value"`
+}
+
+type extDirectLoadParameters struct {
+	Page   int               `json:"page"`
+	Start  int               `json:"start"`
+	Limit  int               `json:"limit"`
+	Filter []extDirectFilter `json:"filter"`
+}
+
+// CleanupPolicyService reads cleanup policies and previews their effect.
+// Nexus OSS only exposes both through the ExtDirect API of the UI.
+type CleanupPolicyService service
+
+func NewCleanupPolicyService(nexusClient *Client) *CleanupPolicyService {
+	return &CleanupPolicyService{
+		Client: nexusClient,
+	}
+}
+
+// Get returns the cleanup policy with the given name or nil if it does not
+// exist
+func (s *CleanupPolicyService) Get(name string) (*CleanupPolicy, error) {
+	var policies []CleanupPolicy
+	if err := extDirectCall(s.Client, cleanupPolicyExtDirectAction, "readAll", nil, &policies); err != nil {
+		return nil, fmt.Errorf("could not read cleanup policy '%s': %w", name, err)
+	}
+	for i := range policies {
+		if policies[i].Name == name {
+			return &policies[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Preview returns the number of components of the repository which the
+// policy would delete and up to limit of these components
+func (s *CleanupPolicyService) Preview(repositoryName string, policy CleanupPolicy, limit int) (int64, []CleanupPreviewComponent, error) {
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return 0, nil, err
+	}
+	parameters := extDirectLoadParameters{
+		Page:  1,
+		Start: 0,
+		Limit: limit,
+		Filter: []extDirectFilter{
+			{Property: "repositoryName", Value: repositoryName},
+			{Property: "cleanupPolicy", Value: string(policyJSON)},
+		},
+	}
+
+	components := []CleanupPreviewComponent{}
+	total, err := extDirectPagedCall(s.Client, cleanupPolicyExtDirectAction, "previewCleanup", []interface{}{parameters}, &components)
+	if err != nil {
+		return 0, nil, fmt.Errorf("could not preview cleanup policy '%s' for repository '%s': %w", policy.Name, repositoryName, err)
+	}
+	return total, components, nil
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	componentsAPIEndpoint = client.BasePath + "v1/components"
+	assetsAPIEndpoint     = client.BasePath + "v1/assets"
+)
+
+// Component is a component stored in a repository, e.g. a maven artifact with all of its assets
+type Component struct {
+	ID         string `json:"id"`
+	Repository string `json:"repository"`
+	Format     string `json:"format"`
+	Group      string `json:"group"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+}
+
+type componentPage struct {
+	Items             []Component `json:"items"`
+	ContinuationToken string      `json:"continuationToken"`
+}
+
+type ComponentService service
+
+func NewComponentService(nexusClient *Client) *ComponentService {
+	return &ComponentService{
+		Client: nexusClient,
+	}
+}
+
+// List returns all components of the given repository, following the
+// continuation tokens of the paginated API
+func (s *ComponentService) List(repoName string) ([]Component, error) {
+	components := []Component{}
+	continuationToken := ""
+
+	for {
+		query := url.Values{}
+		query.Set("repository", repoName)
+		if continuationToken != "" {
+			query.Set("continuationToken", continuationToken)
+		}
+
+		body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s?%s", componentsAPIEndpoint, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("could not list components of repository '%s': HTTP: %d, %s", repoName, resp.StatusCode, string(body))
+		}
+
+		var page componentPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("could not unmarshal components of repository '%s': %v", repoName, err)
+		}
+		components = append(components, page.Items...)
+
+		if page.ContinuationToken == "" {
+			return components, nil
+		}
+		continuationToken = page.ContinuationToken
+	}
+}
+
+// Count returns the number of components of the given repository
+func (s *ComponentService) Count(repoName string) (int, error) {
+	return countRepositoryItems(s.Client, componentsAPIEndpoint, repoName, "components")
+}
+
+// CountAssets returns the number of assets of the given repository,
+// including assets which do not belong to a component, e.g. metadata
+func (s *ComponentService) CountAssets(repoName string) (int, error) {
+	return countRepositoryItems(s.Client, assetsAPIEndpoint, repoName, "assets")
+}
+
+// countRepositoryItems counts the items of a paginated list endpoint
+// without decoding them
+func countRepositoryItems(c *Client, endpoint string, repoName string, kind string) (int, error) {
+	count := 0
+	continuationToken := ""
+
+	for {
+		query := url.Values{}
+		query.Set("repository", repoName)
+		if continuationToken != "" {
+			query.Set("continuationToken", continuationToken)
+		}
+
+		body, resp, err := execute(c, http.MethodGet, fmt.Sprintf("%s?%s", endpoint, query.Encode()), nil)
+		if err != nil {
+			return 0, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return 0, fmt.Errorf("could not list %s of repository '%s': HTTP: %d, %s", kind, repoName, resp.StatusCode, string(body))
+		}
+
+		var page struct {
+			Items             []json.RawMessage `json:"items"`
+			ContinuationToken string            `json:"continuationToken"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return 0, fmt.Errorf("could not unmarshal %s of repository '%s': %v", kind, repoName, err)
+		}
+		count += len(page.Items)
+
+		if page.ContinuationToken == "" {
+			return count, nil
+		}
+		continuationToken = page.ContinuationToken
+	}
+}
+
+func (s *ComponentService) Delete(id string) error {
+	body, resp, err := execute(s.Client, http.MethodDelete, fmt.Sprintf("%s/%s", componentsAPIEndpoint, url.PathEscape(id)), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not delete component '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// CheckConnectivity sends a single request to Nexus to verify URL, TLS
+// settings and credentials. The returned error tells DNS, TLS,
+// authentication and wrong URL failures apart.
+func CheckConnectivity(nexusClient *Client) error {
+	baseURL, err := BaseURL(nexusClient)
+	if err != nil {
+		return err
+	}
+
+	// The health checks need authentication, but only a privilege which not
+	// every user has. Forbidden therefore still proves valid credentials.
+	body, resp, err := execute(nexusClient, http.MethodGet, statusCheckAPIEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("cannot reach Nexus at %s: %s", baseURL, connectivityErrorReason(err))
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusForbidden:
+		return nil
+	case http.StatusUnauthorized:
+		return fmt.Errorf("cannot reach Nexus at %s: authentication failed, check username and password: HTTP: %d", baseURL, resp.StatusCode)
+	case http.StatusNotFound:
+		return fmt.Errorf("cannot reach Nexus at %s: the URL does not point to Nexus, check the url and its path: HTTP: %d", baseURL, resp.StatusCode)
+	default:
+		return fmt.Errorf("cannot reach Nexus at %s: HTTP: %d, %s", baseURL, resp.StatusCode, string(body))
+	}
+}
+
+func connectivityErrorReason(err error) string {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return fmt.Sprintf("DNS lookup of %s failed, check the url: %v", dnsErr.Name, dnsErr)
+	}
+
+	var (
+		unknownAuthorityErr x509.UnknownAuthorityError
+		hostnameErr         x509.HostnameError
+		certificateErr      x509.CertificateInvalidError
+		recordHeaderErr     tls.RecordHeaderError
+	)
+	if errors.As(err, &unknownAuthorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &certificateErr) {
+		return fmt.Sprintf("TLS certificate verification failed, trust the certificate or set insecure: %v", err)
+	}
+	if errors.As(err, &recordHeaderErr) {
+		return fmt.Sprintf("TLS handshake failed, check whether the url should use http instead of https: %v", err)
+	}
+
+	return fmt.Sprintf("connection failed: %v", err)
+}
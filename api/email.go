@@ -0,0 +1,50 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	emailVerifyAPIEndpoint = client.BasePath + "v1/email/verify"
+)
+
+type EmailService service
+
+func NewEmailService(nexusClient *Client) *EmailService {
+	return &EmailService{
+		Client: nexusClient,
+	}
+}
+
+// Verify sends a test email to the address through the configured email
+// server. The error contains the reason Nexus reports if sending failed.
+func (s *EmailService) Verify(address string) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(address)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPost, emailVerifyAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not send test email to '%s': HTTP: %d, %s", address, resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Success bool   `json:"success"`
+		Reason  string `json:"reason"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("could not unmarshal result of test email to '%s': %v", address, err)
+	}
+	if !result.Success {
+		return fmt.Errorf("could not send test email to '%s': %s", address, result.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	extDirectEndpoint = "service/extdirect"
+
+	// The ExtDirect API is internal to the Nexus UI and changes without
+	// notice, its calls are implemented for these versions of Nexus only
+	extDirectMinimumVersion      = "3.37"
+	extDirectMaximumMajorVersion = 3
+)
+
+type extDirectRequest struct {
+	Action string        `json:"action"`
+	Method string        `json:"method"`
+	Data   []interface{} `json:"data"`
+	Type   string        `json:"type"`
+	TID    int           `json:"tid"`
+}
+
+type extDirectResponse struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	Result  struct {
+		Success bool            `json:"success"`
+		Message string          `json:"message"`
+		Total   int64           `json:"total"`
+		Data    json.RawMessage `json:"data"`
+	} `json:"result"`
+}
+
+// extDirectCall invokes a method of the ExtDirect API which backs the Nexus
+// UI. Some features, like verifying LDAP settings, are only exposed there.
+// The data of a successful call is unmarshalled into result unless it is nil.
+func extDirectCall(c *Client, action string, method string, data []interface{}, result interface{}) error {
+	_, err := extDirectPagedCall(c, action, method, data, result)
+	return err
+}
+
+// extDirectPagedCall is extDirectCall for methods which return a page of
+// items. It returns the total number of items of all pages.
+func extDirectPagedCall(c *Client, action string, method string, data []interface{}, result interface{}) (int64, error) {
+	if err := requireExtDirectSupport(c, action, method); err != nil {
+		return 0, err
+	}
+
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(extDirectRequest{
+		Action: action,
+		Method: method,
+		Data:   data,
+		Type:   "rpc",
+		TID:    1,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	body, resp, err := execute(c, http.MethodPost, extDirectEndpoint, ioReader)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var response extDirectResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return 0, fmt.Errorf("could not unmarshal response of %s.%s, the internal API of the Nexus UI may have changed: %v", action, method, err)
+	}
+	if response.Type == "exception" {
+		return 0, errors.New(response.Message)
+	}
+	if !response.Result.Success {
+		return 0, errors.New(response.Result.Message)
+	}
+
+	if result != nil && len(response.Result.Data) > 0 {
+		if err := json.Unmarshal(response.Result.Data, result); err != nil {
+			return 0, fmt.Errorf("could not unmarshal response of %s.%s, the internal API of the Nexus UI may have changed: %v", action, method, err)
+		}
+	}
+	return response.Result.Total, nil
+}
+
+// requireExtDirectSupport returns a friendly error if the pinned or detected
+// version of the Nexus server is not one the ExtDirect calls are implemented
+// for, instead of letting the call fail on a response of another shape. An
+// unknown server version is not checked, the call itself reports a Nexus
+// which can't be reached.
+func requireExtDirectSupport(c *Client, action string, method string) error {
+	serverVersion, err := GetServerVersion(c)
+	if err != nil || serverVersion == nil {
+		return nil
+	}
+	minimumVersion, err := ParseServerVersion(extDirectMinimumVersion)
+	if err != nil {
+		return err
+	}
+	if !serverVersion.AtLeast(minimumVersion) || serverVersion.Major > extDirectMaximumMajorVersion {
+		return fmt.Errorf("%s.%s of the internal API of the Nexus UI is supported for Nexus %s up to %d.x only, but Nexus %s is used. Set nexus_version of the provider if the detected version is wrong",
+			action, method, extDirectMinimumVersion, extDirectMaximumMajorVersion, serverVersion)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	replicationConnectionsAPIEndpoint = client.BasePath + "v1/replication/connection"
+)
+
+// ReplicationConnection replicates the content of a repository to a
+// repository of another Nexus instance
+type ReplicationConnection struct {
+	ID                          string   `json:"id,omitempty"`
+	Name                        string   `json:"name"`
+	SourceRepositoryName        string   `json:"sourceRepositoryName"`
+	DestinationInstanceURL      string   `json:"destinationInstanceUrl"`
+	DestinationInstanceUsername string   `json:"destinationInstanceUsername"`
+	DestinationInstancePassword string   `json:"destinationInstancePassword,omitempty"`
+	DestinationRepositoryName   string   `json:"destinationRepositoryName"`
+	ContentRegexes              []string `json:"contentRegexes"`
+	IncludeExistingContent      bool     `json:"includeExistingContent"`
+}
+
+// ReplicationService manages replication connections. Replication is only
+// available in Nexus PRO, Nexus OSS does not know the endpoints.
+type ReplicationService service
+
+func NewReplicationService(nexusClient *Client) *ReplicationService {
+	return &ReplicationService{
+		Client: nexusClient,
+	}
+}
+
+// Create creates the connection and returns its ID
+func (s *ReplicationService) Create(connection ReplicationConnection) (string, error) {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(connection)
+	if err != nil {
+		return "", err
+	}
+
+	body, resp, err := execute(s.Client, http.MethodPost, replicationConnectionsAPIEndpoint, ioReader)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("could not create replication connection '%s', repository replication requires Nexus PRO: HTTP: %d", connection.Name, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("could not create replication connection '%s': HTTP: %d, %s", connection.Name, resp.StatusCode, string(body))
+	}
+
+	var created ReplicationConnection
+	if err := json.Unmarshal(body, &created); err != nil {
+		return "", fmt.Errorf("could not unmarshal replication connection '%s': %v", connection.Name, err)
+	}
+	return created.ID, nil
+}
+
+// Get returns the connection with the given ID or nil if it does not exist.
+// Nexus never returns the password of the destination instance.
+func (s *ReplicationService) Get(id string) (*ReplicationConnection, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s/%s", replicationConnectionsAPIEndpoint, url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read replication connection '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+
+	var connection ReplicationConnection
+	if err := json.Unmarshal(body, &connection); err != nil {
+		return nil, fmt.Errorf("could not unmarshal replication connection '%s': %v", id, err)
+	}
+	return &connection, nil
+}
+
+func (s *ReplicationService) Update(id string, connection ReplicationConnection) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(connection)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := execute(s.Client, http.MethodPut, fmt.Sprintf("%s/%s", replicationConnectionsAPIEndpoint, url.PathEscape(id)), ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not update replication connection '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *ReplicationService) Delete(id string) error {
+	body, resp, err := execute(s.Client, http.MethodDelete, fmt.Sprintf("%s/%s", replicationConnectionsAPIEndpoint, url.PathEscape(id)), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("could not delete replication connection '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type RepositoryCacheService service
+
+func NewRepositoryCacheService(nexusClient *Client) *RepositoryCacheService {
+	return &RepositoryCacheService{
+		Client: nexusClient,
+	}
+}
+
+// Invalidate invalidates the cache of a proxy or group repository, so the
+// next request of content or metadata goes to the remote again
+func (s *RepositoryCacheService) Invalidate(repoName string) error {
+	body, resp, err := execute(s.Client, http.MethodPost, fmt.Sprintf("%s/%s/invalidate-cache", repositoriesAPIEndpoint, url.PathEscape(repoName)), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not invalidate cache of repository '%s': HTTP: %d, %s", repoName, resp.StatusCode, string(body))
+	}
+	return nil
+}
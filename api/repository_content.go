@@ -0,0 +1,61 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	repositoryContentPath = "repository"
+)
+
+type RepositoryContentService service
+
+func NewRepositoryContentService(nexusClient *Client) *RepositoryContentService {
+	return &RepositoryContentService{
+		Client: nexusClient,
+	}
+}
+
+// Fetch downloads the content at the given path through the repository and
+// discards it. For proxy repositories this caches the content.
+func (s *RepositoryContentService) Fetch(repoName string, path string) error {
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s/%s/%s", repositoryContentPath, url.PathEscape(repoName), strings.TrimPrefix(path, "/")), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not fetch '%s' from repository '%s': HTTP: %d, %s", path, repoName, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// Upload stores the content at the given path of a hosted repository
+func (s *RepositoryContentService) Upload(repoName string, path string, content []byte) error {
+	body, resp, err := execute(s.Client, http.MethodPut, fmt.Sprintf("%s/%s/%s", repositoryContentPath, url.PathEscape(repoName), strings.TrimPrefix(path, "/")), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not upload '%s' to repository '%s': HTTP: %d, %s", path, repoName, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// VerifyRemote sends a HEAD request for the root of the repository. For proxy
+// repositories this makes Nexus contact the remote, which fails with a server
+// error if the remote cannot be reached.
+func (s *RepositoryContentService) VerifyRemote(repoName string) error {
+	_, resp, err := execute(s.Client, http.MethodHead, fmt.Sprintf("%s/%s/", repositoryContentPath, url.PathEscape(repoName)), nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("could not reach the remote of repository '%s': HTTP: %s", repoName, resp.Status)
+	}
+	return nil
+}
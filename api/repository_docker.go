@@ -0,0 +1,234 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	dockerGroupAPIEndpoint  = client.BasePath + "v1/repositories/docker/group"
+	dockerHostedAPIEndpoint = client.BasePath + "v1/repositories/docker/hosted"
+	dockerProxyAPIEndpoint  = client.BasePath + "v1/repositories/docker/proxy"
+)
+
+// DockerGroupRepository extends repository.DockerGroupRepository with the
+// subdomain connector
+type DockerGroupRepository struct {
+	repository.DockerGroupRepository
+
+	Docker Docker `json:"docker"`
+}
+
+// DockerHostedRepository extends repository.DockerHostedRepository with the
+// docker specific storage settings, the subdomain connector and the
+// certificate of the HTTPS connector
+type DockerHostedRepository struct {
+	repository.DockerHostedRepository
+
+	Docker  Docker              `json:"docker"`
+	Storage DockerHostedStorage `json:"storage"`
+}
+
+// DockerHostedStorage contains the storage configuration of a Docker Hosted
+// Repository including the redeploy policy of the latest tag
+type DockerHostedStorage struct {
+	repository.HostedStorage
+
+	// Allow redeploying the 'latest' tag but defer to the write policy for all other tags
+	LatestPolicy *bool `json:"latestPolicy,omitempty"`
+}
+
+// DockerProxyRepository extends repository.DockerProxyRepository with the
+// foreign layer settings of the docker proxy configuration and the subdomain
+// connector
+type DockerProxyRepository struct {
+	repository.DockerProxyRepository
+
+	Docker      Docker      `json:"docker"`
+	DockerProxy DockerProxy `json:"dockerProxy"`
+}
+
+// Docker contains data of a Docker Repository including the subdomain connector
+// and the certificate of the HTTPS connector
+type Docker struct {
+	repository.Docker
+
+	// Alias of the server certificate the HTTPS connector presents
+	HTTPSCertificateAlias *string `json:"httpsCertificateAlias,omitempty"`
+	// Use the repository name as subdomain to reach it, requires Nexus >= 3.38
+	Subdomain *string `json:"subdomain,omitempty"`
+}
+
+// DockerProxy contains data of a Docker Proxy Repository including foreign layer caching
+type DockerProxy struct {
+	repository.DockerProxy
+
+	// Allow Nexus Repository Manager to download and cache foreign layers
+	CacheForeignLayers bool `json:"cacheForeignLayers"`
+	// Regular expressions used to identify URLs that are allowed for foreign layer requests
+	ForeignLayerURLWhitelist []string `json:"foreignLayerUrlWhitelist,omitempty"`
+}
+
+type RepositoryDockerProxyService service
+
+func NewRepositoryDockerProxyService(nexusClient *Client) *RepositoryDockerProxyService {
+	return &RepositoryDockerProxyService{
+		Client: nexusClient,
+	}
+}
+
+func (s *RepositoryDockerProxyService) Create(repo DockerProxyRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPost, dockerProxyAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", repo.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryDockerProxyService) Get(id string) (*DockerProxyRepository, error) {
+	var repo DockerProxyRepository
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s/%s", dockerProxyAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryDockerProxyService) Update(id string, repo DockerProxyRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPut, fmt.Sprintf("%s/%s", dockerProxyAPIEndpoint, id), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+type RepositoryDockerHostedService service
+
+func NewRepositoryDockerHostedService(nexusClient *Client) *RepositoryDockerHostedService {
+	return &RepositoryDockerHostedService{
+		Client: nexusClient,
+	}
+}
+
+func (s *RepositoryDockerHostedService) Create(repo DockerHostedRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPost, dockerHostedAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", repo.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryDockerHostedService) Get(id string) (*DockerHostedRepository, error) {
+	var repo DockerHostedRepository
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s/%s", dockerHostedAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryDockerHostedService) Update(id string, repo DockerHostedRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPut, fmt.Sprintf("%s/%s", dockerHostedAPIEndpoint, id), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+type RepositoryDockerGroupService service
+
+func NewRepositoryDockerGroupService(nexusClient *Client) *RepositoryDockerGroupService {
+	return &RepositoryDockerGroupService{
+		Client: nexusClient,
+	}
+}
+
+func (s *RepositoryDockerGroupService) Create(repo DockerGroupRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPost, dockerGroupAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", repo.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryDockerGroupService) Get(id string) (*DockerGroupRepository, error) {
+	var repo DockerGroupRepository
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s/%s", dockerGroupAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryDockerGroupService) Update(id string, repo DockerGroupRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPut, fmt.Sprintf("%s/%s", dockerGroupAPIEndpoint, id), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	repositoriesAPIEndpoint = client.BasePath + "v1/repositories"
+)
+
+// RepositoryGroup is a group repository of any format. The complete
+// configuration returned by Nexus is kept, so an update only changes the
+// members of the group.
+type RepositoryGroup struct {
+	Format      string
+	Name        string
+	MemberNames []string
+
+	config map[string]interface{}
+}
+
+type RepositoryGroupService service
+
+func NewRepositoryGroupService(nexusClient *Client) *RepositoryGroupService {
+	return &RepositoryGroupService{
+		Client: nexusClient,
+	}
+}
+
+// Get returns the group repository of the given format, or nil if it does
+// not exist
+func (s *RepositoryGroupService) Get(format string, name string) (*RepositoryGroup, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, repositoryGroupEndpoint(format, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read group repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var config map[string]interface{}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("could not unmarshal group repository '%s': %v", name, err)
+	}
+
+	group := &RepositoryGroup{
+		Format:      format,
+		Name:        name,
+		MemberNames: []string{},
+		config:      config,
+	}
+	if groupConfig, ok := config["group"].(map[string]interface{}); ok {
+		if memberNames, ok := groupConfig["memberNames"].([]interface{}); ok {
+			for _, memberName := range memberNames {
+				group.MemberNames = append(group.MemberNames, memberName.(string))
+			}
+		}
+	}
+	return group, nil
+}
+
+// Update writes the members of the group back to Nexus
+func (s *RepositoryGroupService) Update(group *RepositoryGroup) error {
+	groupConfig, ok := group.config["group"].(map[string]interface{})
+	if !ok {
+		groupConfig = map[string]interface{}{}
+		group.config["group"] = groupConfig
+	}
+	groupConfig["memberNames"] = group.MemberNames
+
+	data, err := tools.JsonMarshalInterfaceToIOReader(group.config)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPut, repositoryGroupEndpoint(group.Format, group.Name), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not update group repository '%s': HTTP: %d, %s", group.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func repositoryGroupEndpoint(format string, name string) string {
+	return repositoryEndpoint(format, repository.RepositoryTypeGroup, name)
+}
+
+func repositoryEndpoint(format string, repositoryType string, name string) string {
+	// The REST API names the maven2 format "maven" in its paths
+	if format == repository.RepositoryFormatMaven2 {
+		format = "maven"
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", repositoriesAPIEndpoint, format, repositoryType, url.PathEscape(name))
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	mavenGroupAPIEndpoint = client.BasePath + "v1/repositories/maven/group"
+)
+
+// MavenGroupRepository extends repository.MavenGroupRepository with the maven
+// specific settings of the group
+type MavenGroupRepository struct {
+	repository.MavenGroupRepository
+
+	// Nil if Nexus does not report the maven settings of the group
+	Maven *repository.Maven `json:"maven,omitempty"`
+}
+
+type RepositoryMavenGroupService service
+
+func NewRepositoryMavenGroupService(nexusClient *Client) *RepositoryMavenGroupService {
+	return &RepositoryMavenGroupService{
+		Client: nexusClient,
+	}
+}
+
+func (s *RepositoryMavenGroupService) Create(repo MavenGroupRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPost, mavenGroupAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", repo.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryMavenGroupService) Get(id string) (*MavenGroupRepository, error) {
+	var repo MavenGroupRepository
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s/%s", mavenGroupAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryMavenGroupService) Update(id string, repo MavenGroupRepository) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPut, fmt.Sprintf("%s/%s", mavenGroupAPIEndpoint, id), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
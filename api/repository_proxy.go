@@ -0,0 +1,90 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+// RepositoryProxy is a proxy repository of any format. The complete
+// configuration returned by Nexus is kept, so an update only changes the
+// routing rule of the proxy.
+type RepositoryProxy struct {
+	Format      string
+	Name        string
+	RoutingRule string
+
+	config map[string]interface{}
+}
+
+type RepositoryProxyService service
+
+func NewRepositoryProxyService(nexusClient *Client) *RepositoryProxyService {
+	return &RepositoryProxyService{
+		Client: nexusClient,
+	}
+}
+
+// Get returns the proxy repository of the given format, or nil if it does
+// not exist
+func (s *RepositoryProxyService) Get(format string, name string) (*RepositoryProxy, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, repositoryProxyEndpoint(format, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read proxy repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var config map[string]interface{}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("could not unmarshal proxy repository '%s': %v", name, err)
+	}
+
+	proxy := &RepositoryProxy{
+		Format: format,
+		Name:   name,
+		config: config,
+	}
+	// Nexus returns the rule as routingRuleName, but expects routingRule on update
+	if routingRule, ok := config["routingRuleName"].(string); ok {
+		proxy.RoutingRule = routingRule
+	} else if routingRule, ok := config["routingRule"].(string); ok {
+		proxy.RoutingRule = routingRule
+	}
+	return proxy, nil
+}
+
+// Update writes the routing rule of the proxy back to Nexus, an empty
+// routing rule removes the rule from the proxy
+func (s *RepositoryProxyService) Update(proxy *RepositoryProxy) error {
+	var routingRule interface{}
+	if proxy.RoutingRule != "" {
+		routingRule = proxy.RoutingRule
+	}
+	proxy.config["routingRule"] = routingRule
+	proxy.config["routingRuleName"] = routingRule
+
+	data, err := tools.JsonMarshalInterfaceToIOReader(proxy.config)
+	if err != nil {
+		return err
+	}
+	body, resp, err := execute(s.Client, http.MethodPut, repositoryProxyEndpoint(proxy.Format, proxy.Name), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not update proxy repository '%s': HTTP: %d, %s", proxy.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func repositoryProxyEndpoint(format string, name string) string {
+	return repositoryEndpoint(format, repository.RepositoryTypeProxy, name)
+}
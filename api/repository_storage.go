@@ -0,0 +1,48 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RepositoryStorage is the storage configuration shared by repositories of
+// all formats and types
+type RepositoryStorage struct {
+	BlobStoreName               string  `json:"blobStoreName"`
+	StrictContentTypeValidation bool    `json:"strictContentTypeValidation"`
+	WritePolicy                 *string `json:"writePolicy,omitempty"`
+}
+
+// RepositoryStorageService reads the storage configuration of a repository
+// regardless of its format
+type RepositoryStorageService service
+
+func NewRepositoryStorageService(nexusClient *Client) *RepositoryStorageService {
+	return &RepositoryStorageService{
+		Client: nexusClient,
+	}
+}
+
+// Get returns the storage configuration of the repository, or nil if it
+// does not exist
+func (s *RepositoryStorageService) Get(format string, repositoryType string, name string) (*RepositoryStorage, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, repositoryEndpoint(format, repositoryType, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var repo struct {
+		Storage RepositoryStorage `json:"storage"`
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository '%s': %v", name, err)
+	}
+	return &repo.Storage, nil
+}
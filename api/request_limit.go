@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"sync"
+)
+
+type requestSlotKey struct{}
+
+// RequestLimit caps the number of requests which are in flight at the same
+// time, so applies with many resources don't overwhelm small Nexus instances
+type RequestLimit struct {
+	semaphore chan struct{}
+}
+
+// NewRequestLimit returns a limit of maxConcurrentRequests requests
+func NewRequestLimit(maxConcurrentRequests int) *RequestLimit {
+	return &RequestLimit{
+		semaphore: make(chan struct{}, maxConcurrentRequests),
+	}
+}
+
+// Acquire waits for a free slot and returns a copy of ctx which holds it,
+// along with a function which frees the slot again. Requests bound to a
+// context which already holds a slot don't take another one, so an operation
+// which sends its requests one after the other needs a single slot.
+func (l *RequestLimit) Acquire(ctx context.Context) (context.Context, func(), error) {
+	if l == nil || HoldsRequestSlot(ctx) {
+		return ctx, func() {}, nil
+	}
+
+	select {
+	case l.semaphore <- struct{}{}:
+	case <-ctx.Done():
+		return ctx, func() {}, ctx.Err()
+	}
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() { <-l.semaphore })
+	}
+	return context.WithValue(ctx, requestSlotKey{}, true), release, nil
+}
+
+// HoldsRequestSlot returns whether ctx holds a slot of a RequestLimit
+func HoldsRequestSlot(ctx context.Context) bool {
+	held, _ := ctx.Value(requestSlotKey{}).(bool)
+	return held
+}
+
+// withoutRequestLimit returns a copy of ctx whose requests don't wait for a
+// slot of a RequestLimit
+func withoutRequestLimit(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestSlotKey{}, true)
+}
+
+// SetRequestLimit sets the limit the requests of the client are subject to
+func SetRequestLimit(c *Client, limit *RequestLimit) {
+	c.settings.requestLimit = limit
+}
+
+// AcquireRequestSlot waits for a free slot of the limit set for the client,
+// see RequestLimit.Acquire. Clients without a limit don't wait.
+func AcquireRequestSlot(ctx context.Context, c *Client) (context.Context, func(), error) {
+	return c.settings.requestLimit.Acquire(ctx)
+}
@@ -0,0 +1,17 @@
+package api
+
+const (
+	// DefaultRetries is the number of retries of a client without configured retries
+	DefaultRetries = 3
+)
+
+// SetRetries sets how often requests of the client which may fail
+// temporarily, e.g. while a Nexus cluster propagates a change, are retried
+func SetRetries(c *Client, retries int) {
+	c.settings.retries = retries
+}
+
+// GetRetries returns the retries set for the client, or DefaultRetries
+func GetRetries(c *Client) int {
+	return c.settings.retries
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+)
+
+const (
+	routingRuleTestEndpoint = "service/rest/internal/ui/routing-rules/test"
+)
+
+type routingRuleTestRequest struct {
+	Mode     schema.RoutingRuleMode `json:"mode"`
+	Matchers []string               `json:"matchers"`
+	Path     string                 `json:"path"`
+}
+
+// RoutingRuleService tests routing rules. Nexus only exposes this through
+// the internal API of the UI.
+type RoutingRuleService service
+
+func NewRoutingRuleService(nexusClient *Client) *RoutingRuleService {
+	return &RoutingRuleService{
+		Client: nexusClient,
+	}
+}
+
+// Test returns whether a request for the given path is allowed by the rule
+func (s *RoutingRuleService) Test(rule schema.RoutingRule, path string) (bool, error) {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(routingRuleTestRequest{
+		Mode:     rule.Mode,
+		Matchers: rule.Matchers,
+		Path:     path,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	body, resp, err := execute(s.Client, http.MethodPost, routingRuleTestEndpoint, ioReader)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("could not test routing rule '%s': HTTP: %d, %s", rule.Name, resp.StatusCode, string(body))
+	}
+
+	var allowed bool
+	if err := json.Unmarshal(body, &allowed); err != nil {
+		return false, fmt.Errorf("could not unmarshal result of routing rule test: %v", err)
+	}
+	return allowed, nil
+}
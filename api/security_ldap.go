@@ -0,0 +1,171 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+const (
+	ldapExtDirectAction = "ldap_LdapServer"
+	// ldapPasswordPlaceholder makes Nexus use the stored bind password of the
+	// LDAP server, as it is never returned by the API
+	ldapPasswordPlaceholder = "#~NXRM~PLACEHOLDER~PASSWORD~#"
+)
+
+type SecurityLDAPService service
+
+func NewSecurityLDAPService(nexusClient *Client) *SecurityLDAPService {
+	return &SecurityLDAPService{
+		Client: nexusClient,
+	}
+}
+
+// ldapServerConnection is the connection part of an LDAP server as expected
+// by the ExtDirect API
+type ldapServerConnection struct {
+	ID                   string `json:"id,omitempty"`
+	Name                 string `json:"name"`
+	Protocol             string `json:"protocol"`
+	UseTrustStore        bool   `json:"useTrustStore"`
+	Host                 string `json:"host"`
+	Port                 int32  `json:"port"`
+	SearchBase           string `json:"searchBase"`
+	AuthScheme           string `json:"authScheme"`
+	AuthRealm            string `json:"authRealm,omitempty"`
+	AuthUsername         string `json:"authUsername,omitempty"`
+	AuthPassword         string `json:"authPassword,omitempty"`
+	ConnectionTimeout    int32  `json:"connectionTimeout"`
+	ConnectionRetryDelay int32  `json:"connectionRetryDelay"`
+	MaxIncidentsCount    int32  `json:"maxIncidentsCount"`
+}
+
+func newLDAPServerConnection(ldap security.LDAP) ldapServerConnection {
+	authPassword := ldap.AuthPassword
+	if authPassword == "" && ldap.ID != "" {
+		authPassword = ldapPasswordPlaceholder
+	}
+
+	return ldapServerConnection{
+		ID:                   ldap.ID,
+		Name:                 ldap.Name,
+		Protocol:             ldap.Protocol,
+		UseTrustStore:        ldap.UseTrustStore,
+		Host:                 ldap.Host,
+		Port:                 ldap.Port,
+		SearchBase:           ldap.SearchBase,
+		AuthScheme:           ldap.AuthSchema,
+		AuthRealm:            ldap.AuthRealm,
+		AuthUsername:         ldap.AuthUserName,
+		AuthPassword:         authPassword,
+		ConnectionTimeout:    ldap.ConnectionTimeoutSeconds,
+		ConnectionRetryDelay: ldap.ConnectionRetryDelaySeconds,
+		MaxIncidentsCount:    ldap.MaxIncidentCount,
+	}
+}
+
+// VerifyConnection checks whether Nexus is able to connect and bind to the
+// given LDAP server. The returned error contains the diagnostic of the server.
+func (s *SecurityLDAPService) VerifyConnection(ldap security.LDAP) error {
+	if err := extDirectCall(s.Client, ldapExtDirectAction, "verifyConnection", []interface{}{newLDAPServerConnection(ldap)}, nil); err != nil {
+		return fmt.Errorf("could not verify connection of LDAP server '%s': %w", ldap.Name, err)
+	}
+	return nil
+}
+
+// ldapServerConfiguration is the connection and the user and group mapping of
+// an LDAP server as expected by the ExtDirect API
+type ldapServerConfiguration struct {
+	ldapServerConnection
+	UserBaseDN                string `json:"userBaseDn,omitempty"`
+	UserSubtree               bool   `json:"userSubtree"`
+	UserObjectClass           string `json:"userObjectClass,omitempty"`
+	UserLDAPFilter            string `json:"userLdapFilter,omitempty"`
+	UserIDAttribute           string `json:"userIdAttribute,omitempty"`
+	UserRealNameAttribute     string `json:"userRealNameAttribute,omitempty"`
+	UserEmailAddressAttribute string `json:"userEmailAddressAttribute,omitempty"`
+	UserPasswordAttribute     string `json:"userPasswordAttribute,omitempty"`
+	LDAPGroupsAsRoles         bool   `json:"ldapGroupsAsRoles"`
+	GroupType                 string `json:"groupType,omitempty"`
+	GroupBaseDN               string `json:"groupBaseDn,omitempty"`
+	GroupSubtree              bool   `json:"groupSubtree"`
+	GroupObjectClass          string `json:"groupObjectClass,omitempty"`
+	GroupIDAttribute          string `json:"groupIdAttribute,omitempty"`
+	GroupMemberAttribute      string `json:"groupMemberAttribute,omitempty"`
+	GroupMemberFormat         string `json:"groupMemberFormat,omitempty"`
+	UserMemberOfAttribute     string `json:"userMemberOfAttribute,omitempty"`
+}
+
+func newLDAPServerConfiguration(ldap security.LDAP) ldapServerConfiguration {
+	return ldapServerConfiguration{
+		ldapServerConnection:      newLDAPServerConnection(ldap),
+		UserBaseDN:                ldap.UserBaseDN,
+		UserSubtree:               ldap.UserSubtree,
+		UserObjectClass:           ldap.UserObjectClass,
+		UserLDAPFilter:            ldap.UserLDAPFilter,
+		UserIDAttribute:           ldap.UserIDAttribute,
+		UserRealNameAttribute:     ldap.UserRealNameAttribute,
+		UserEmailAddressAttribute: ldap.UserEmailAddressAttribute,
+		UserPasswordAttribute:     ldap.UserPasswordAttribute,
+		LDAPGroupsAsRoles:         ldap.LDAPGroupsAsRoles,
+		GroupType:                 ldap.GroupType,
+		GroupBaseDN:               ldap.GroupBaseDn,
+		GroupSubtree:              ldap.GroupSubtree,
+		GroupObjectClass:          ldap.GroupObjectClass,
+		GroupIDAttribute:          ldap.GroupIDAttribute,
+		GroupMemberAttribute:      ldap.GroupMemberAttribute,
+		GroupMemberFormat:         ldap.GroupMemberFormat,
+		UserMemberOfAttribute:     ldap.UserMemberOfAttribute,
+	}
+}
+
+// LDAPUser is a user as resolved by the user and group mapping of an LDAP server
+type LDAPUser struct {
+	Username   string   `json:"username"`
+	RealName   string   `json:"realName"`
+	Email      string   `json:"email"`
+	Membership []string `json:"membership"`
+}
+
+// VerifyUserMapping resolves the given user with the user and group mapping of
+// the LDAP server. It returns nil if the user could not be found.
+func (s *SecurityLDAPService) VerifyUserMapping(ldap security.LDAP, username string) (*LDAPUser, error) {
+	// Narrow the search down to the requested user, otherwise Nexus only
+	// returns the first users of the directory
+	userFilter := fmt.Sprintf("(%s=%s)", ldap.UserIDAttribute, ldapEscapeFilterValue(username))
+	if ldap.UserLDAPFilter != "" {
+		userFilter = fmt.Sprintf("(&%s%s)", ldapWrapFilter(ldap.UserLDAPFilter), userFilter)
+	}
+	ldap.UserLDAPFilter = userFilter
+
+	var users []LDAPUser
+	if err := extDirectCall(s.Client, ldapExtDirectAction, "verifyUserMapping", []interface{}{newLDAPServerConfiguration(ldap)}, &users); err != nil {
+		return nil, fmt.Errorf("could not verify user mapping of LDAP server '%s': %w", ldap.Name, err)
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Username, username) {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+func ldapWrapFilter(filter string) string {
+	if strings.HasPrefix(filter, "(") {
+		return filter
+	}
+	return fmt.Sprintf("(%s)", filter)
+}
+
+// ldapEscapeFilterValue escapes the special characters of RFC 4515
+func ldapEscapeFilterValue(value string) string {
+	return strings.NewReplacer(
+		`\`, `\5c`,
+		"*", `\2a`,
+		"(", `\28`,
+		")", `\29`,
+		"\x00", `\00`,
+	).Replace(value)
+}
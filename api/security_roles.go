@@ -0,0 +1,50 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	securityRolesAPIEndpoint = client.BasePath + "v1/security/roles"
+)
+
+// SourceRole is a role of a role source, e.g. a group of an LDAP server
+type SourceRole struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Source      string `json:"source"`
+}
+
+type SecurityRolesService service
+
+func NewSecurityRolesService(nexusClient *Client) *SecurityRolesService {
+	return &SecurityRolesService{
+		Client: nexusClient,
+	}
+}
+
+// List returns the roles of the given source, e.g. `LDAP` or `Crowd`
+func (s *SecurityRolesService) List(source string) ([]SourceRole, error) {
+	query := url.Values{}
+	query.Set("source", source)
+
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s?%s", securityRolesAPIEndpoint, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list roles of source '%s': HTTP: %d, %s", source, resp.StatusCode, string(body))
+	}
+
+	var roles []SourceRole
+	if err := json.Unmarshal(body, &roles); err != nil {
+		return nil, fmt.Errorf("could not unmarshal roles: %v", err)
+	}
+	return roles, nil
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	securityUsersAPIEndpoint = client.BasePath + "v1/security/users"
+)
+
+type SecurityUserTokenService service
+
+func NewSecurityUserTokenService(nexusClient *Client) *SecurityUserTokenService {
+	return &SecurityUserTokenService{
+		Client: nexusClient,
+	}
+}
+
+// Reset invalidates the user token of the given user
+func (s *SecurityUserTokenService) Reset(userID string) error {
+	body, resp, err := execute(s.Client, http.MethodDelete, fmt.Sprintf("%s/%s/user-token", securityUsersAPIEndpoint, url.PathEscape(userID)), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not reset user token of user '%s': HTTP: %d, %s", userID, resp.StatusCode, string(body))
+	}
+	return nil
+}
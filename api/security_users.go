@@ -0,0 +1,120 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+const (
+	// securityUsersPageSize is the maximum number of users Nexus returns for a
+	// single search
+	securityUsersPageSize = 100
+	// securityUsersMaxPrefixLength limits how deep a truncated search is split up
+	securityUsersMaxPrefixLength  = 8
+	securityUsersPrefixCharacters = "abcdefghijklmnopqrstuvwxyz0123456789-_.@"
+
+	securityUserSourcesAPIEndpoint = client.BasePath + "v1/security/user-sources"
+)
+
+// UserSource is a source of users, e.g. the local users or an LDAP server
+type UserSource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type SecurityUsersService service
+
+func NewSecurityUsersService(nexusClient *Client) *SecurityUsersService {
+	return &SecurityUsersService{
+		Client: nexusClient,
+	}
+}
+
+// List returns all users of the given source, or of all sources if source is
+// empty. The users API of Nexus is not paginated but truncates large results,
+// so truncated searches are split up by the next character of the user ID
+// until every page is complete.
+func (s *SecurityUsersService) List(source string) ([]security.User, error) {
+	users := []security.User{}
+	seen := map[string]bool{}
+	if err := s.listByPrefix(source, "", &users, seen); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (s *SecurityUsersService) listByPrefix(source string, prefix string, users *[]security.User, seen map[string]bool) error {
+	page, err := s.search(source, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, user := range page {
+		key := user.Source + "/" + user.UserID
+		if !seen[key] {
+			seen[key] = true
+			*users = append(*users, user)
+		}
+	}
+
+	if len(page) < securityUsersPageSize || len(prefix) >= securityUsersMaxPrefixLength {
+		return nil
+	}
+	for _, c := range securityUsersPrefixCharacters {
+		if err := s.listByPrefix(source, prefix+string(c), users, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SecurityUsersService) search(source string, userIDPrefix string) ([]security.User, error) {
+	query := url.Values{}
+	if source != "" {
+		query.Set("source", source)
+	}
+	if userIDPrefix != "" {
+		query.Set("userId", userIDPrefix)
+	}
+
+	endpoint := securityUsersAPIEndpoint
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	body, resp, err := execute(s.Client, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list users: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var users []security.User
+	if err := json.Unmarshal(body, &users); err != nil {
+		return nil, fmt.Errorf("could not unmarshal users: %v", err)
+	}
+	return users, nil
+}
+
+// ListSources returns the sources of users Nexus knows about
+func (s *SecurityUsersService) ListSources() ([]UserSource, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, securityUserSourcesAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list user sources: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var sources []UserSource
+	if err := json.Unmarshal(body, &sources); err != nil {
+		return nil, fmt.Errorf("could not unmarshal user sources: %v", err)
+	}
+	return sources, nil
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	statusAPIEndpoint = client.BasePath + "v1/status"
+)
+
+var (
+	serverVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?`)
+	// Nexus reports its version in the Server header, e.g. "Nexus/3.38.1-01 (OSS)"
+	serverHeaderPattern = regexp.MustCompile(`Nexus/(\d+\.\d+(?:\.\d+)?\S*)`)
+)
+
+// ServerVersion is the version of a Nexus server, e.g. 3.38.1
+type ServerVersion struct {
+	Major int
+	Minor int
+	Patch int
+
+	raw string
+}
+
+// ParseServerVersion parses versions like 3.38, 3.38.1 or 3.38.1-01
+func ParseServerVersion(version string) (*ServerVersion, error) {
+	matches := serverVersionPattern.FindStringSubmatch(version)
+	if matches == nil {
+		return nil, fmt.Errorf("invalid Nexus version %q, expected e.g. 3.38.1", version)
+	}
+	serverVersion := &ServerVersion{raw: version}
+	serverVersion.Major, _ = strconv.Atoi(matches[1])
+	serverVersion.Minor, _ = strconv.Atoi(matches[2])
+	if matches[3] != "" {
+		serverVersion.Patch, _ = strconv.Atoi(matches[3])
+	}
+	return serverVersion, nil
+}
+
+// AtLeast returns whether the version is the same as or newer than the given one
+func (v *ServerVersion) AtLeast(other *ServerVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch >= other.Patch
+}
+
+func (v *ServerVersion) String() string {
+	return v.raw
+}
+
+type serverVersionEntry struct {
+	mutex   sync.Mutex
+	known   bool
+	version *ServerVersion
+	err     error
+}
+
+// PinServerVersion makes GetServerVersion return the given version for the
+// client instead of asking Nexus
+func PinServerVersion(c *Client, version string) error {
+	serverVersion, err := ParseServerVersion(version)
+	if err != nil {
+		return err
+	}
+	entry := &c.settings.serverVersion
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+	entry.known, entry.version, entry.err = true, serverVersion, nil
+	return nil
+}
+
+// GetServerVersion returns the pinned version of the Nexus server, or
+// detects it once via the status endpoint. It returns nil if Nexus does not
+// reveal its version. Copies made by WithContext share the version of the
+// client they were made of.
+func GetServerVersion(c *Client) (*ServerVersion, error) {
+	entry := &c.settings.serverVersion
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+	if !entry.known {
+		// Detected without the context of a copy, so the result does not
+		// depend on it. Nor does it wait for a request slot, as the
+		// operations which hold the slots may wait for the version.
+		entry.version, entry.err = detectServerVersion(WithContext(withoutRequestLimit(context.Background()), withoutContext(c)))
+		entry.known = true
+	}
+	return entry.version, entry.err
+}
+
+func detectServerVersion(c *Client) (*ServerVersion, error) {
+	body, resp, err := execute(c, http.MethodGet, statusAPIEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not detect Nexus version: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not detect Nexus version: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	matches := serverHeaderPattern.FindStringSubmatch(resp.Header.Get("Server"))
+	if matches == nil {
+		return nil, nil
+	}
+	return ParseServerVersion(matches[1])
+}
+
+// RequireServerVersion returns a friendly error if the Nexus server is older
+// than the minimum version the given feature needs. An unknown server version
+// is not checked.
+func RequireServerVersion(c *Client, feature string, minimum string) error {
+	minimumVersion, err := ParseServerVersion(minimum)
+	if err != nil {
+		return err
+	}
+	serverVersion, err := GetServerVersion(c)
+	if err != nil {
+		return err
+	}
+	if serverVersion != nil && !serverVersion.AtLeast(minimumVersion) {
+		return fmt.Errorf("%s requires Nexus >= %s, but Nexus %s is used", feature, minimum, serverVersion)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	sslAPIEndpoint = client.BasePath + "v1/security/ssl"
+)
+
+// Certificate is a certificate which Nexus retrieved from a remote host
+type Certificate struct {
+	SubjectCommonName string `json:"subjectCommonName"`
+	IssuerCommonName  string `json:"issuerCommonName"`
+	Fingerprint       string `json:"fingerprint"`
+}
+
+type SSLService service
+
+func NewSSLService(nexusClient *Client) *SSLService {
+	return &SSLService{
+		Client: nexusClient,
+	}
+}
+
+// GetCertificate makes Nexus connect to the given host and returns the
+// certificate it presents. The error tells why Nexus cannot connect.
+func (s *SSLService) GetCertificate(host string, port int) (*Certificate, error) {
+	query := url.Values{}
+	query.Set("host", host)
+	query.Set("port", strconv.Itoa(port))
+
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s?%s", sslAPIEndpoint, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not retrieve certificate of '%s:%d': HTTP: %d, %s", host, port, resp.StatusCode, string(body))
+	}
+
+	var certificate Certificate
+	if err := json.Unmarshal(body, &certificate); err != nil {
+		return nil, fmt.Errorf("could not unmarshal certificate of '%s:%d': %v", host, port, err)
+	}
+	return &certificate, nil
+}
+
+// ListTruststoreCertificates returns the certificates in the truststore of
+// Nexus
+func (s *SSLService) ListTruststoreCertificates() ([]Certificate, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, sslAPIEndpoint+"/truststore", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list truststore certificates: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var certificates []Certificate
+	if err := json.Unmarshal(body, &certificates); err != nil {
+		return nil, fmt.Errorf("could not unmarshal truststore certificates: %v", err)
+	}
+	return certificates, nil
+}
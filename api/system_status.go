@@ -0,0 +1,80 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	statusCheckAPIEndpoint = client.BasePath + "v1/status/check"
+)
+
+// Nexus reports version and edition in the Server header, e.g. "Nexus/3.38.1-01 (OSS)"
+var serverHeaderEditionPattern = regexp.MustCompile(`Nexus/(\S+)\s+\((\w+)\)`)
+
+// SystemStatus is the version, edition and health of a Nexus server
+type SystemStatus struct {
+	// OSS or PRO
+	Edition string
+	Version string
+	Checks  []SystemStatusCheck
+}
+
+// SystemStatusCheck is the result of a single health check
+type SystemStatusCheck struct {
+	Name    string
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message"`
+}
+
+type SystemStatusService service
+
+func NewSystemStatusService(nexusClient *Client) *SystemStatusService {
+	return &SystemStatusService{
+		Client: nexusClient,
+	}
+}
+
+// Get runs the health checks of Nexus. Connectivity and authentication
+// failures are reported with distinct errors.
+func (s *SystemStatusService) Get() (*SystemStatus, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, statusCheckAPIEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to Nexus: %w", err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed, check username and password: HTTP: %d", resp.StatusCode)
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied, the user needs the privilege nx-metrics-all: HTTP: %d", resp.StatusCode)
+	default:
+		return nil, fmt.Errorf("could not read system status: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var checks map[string]SystemStatusCheck
+	if err := json.Unmarshal(body, &checks); err != nil {
+		return nil, fmt.Errorf("could not unmarshal system status: %v", err)
+	}
+
+	status := &SystemStatus{
+		Checks: []SystemStatusCheck{},
+	}
+	if matches := serverHeaderEditionPattern.FindStringSubmatch(resp.Header.Get("Server")); matches != nil {
+		status.Version = matches[1]
+		status.Edition = matches[2]
+	}
+	for name, check := range checks {
+		check.Name = name
+		status.Checks = append(status.Checks, check)
+	}
+	sort.Slice(status.Checks, func(i, j int) bool {
+		return status.Checks[i].Name < status.Checks[j].Name
+	})
+	return status, nil
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	tasksAPIEndpoint    = client.BasePath + "v1/tasks"
+	taskExtDirectAction = "coreui_Task"
+
+	// TaskTypeChangeRepositoryBlobStore is the "Admin - Change repository blob store" task
+	TaskTypeChangeRepositoryBlobStore = "repository.move"
+	// TaskTypeRebuildRepositoryIndex is the "Repair - Rebuild repository search" task
+	TaskTypeRebuildRepositoryIndex = "repository.rebuild-index"
+
+	TaskStateRunning = "RUNNING"
+	TaskResultFailed = "FAILED"
+)
+
+// Task is the state of a Nexus task as reported by the tasks API
+type Task struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Message       string `json:"message"`
+	CurrentState  string `json:"currentState"`
+	LastRunResult string `json:"lastRunResult"`
+	LastRun       string `json:"lastRun"`
+}
+
+type taskPage struct {
+	Items             []Task `json:"items"`
+	ContinuationToken string `json:"continuationToken"`
+}
+
+// newTask is a manually scheduled task as expected by the ExtDirect API
+type newTask struct {
+	ID                    string            `json:"id"`
+	TypeID                string            `json:"typeId"`
+	Name                  string            `json:"name"`
+	Enabled               bool              `json:"enabled"`
+	NotificationCondition string            `json:"notificationCondition"`
+	Schedule              string            `json:"schedule"`
+	Properties            map[string]string `json:"properties"`
+}
+
+// TaskService creates and runs tasks. Nexus only allows to create them
+// through the ExtDirect API of the UI.
+type TaskService service
+
+func NewTaskService(nexusClient *Client) *TaskService {
+	return &TaskService{
+		Client: nexusClient,
+	}
+}
+
+// Create creates a manually scheduled task and returns its ID
+func (s *TaskService) Create(typeID string, name string, properties map[string]string) (string, error) {
+	var created struct {
+		ID string `json:"id"`
+	}
+	task := newTask{
+		TypeID:                typeID,
+		Name:                  name,
+		Enabled:               true,
+		NotificationCondition: "FAILURE",
+		Schedule:              "manual",
+		Properties:            properties,
+	}
+	if err := extDirectCall(s.Client, taskExtDirectAction, "create", []interface{}{task}, &created); err != nil {
+		return "", fmt.Errorf("could not create task '%s': %w", name, err)
+	}
+	return created.ID, nil
+}
+
+// Get returns the task with the given ID or nil if it does not exist
+func (s *TaskService) Get(id string) (*Task, error) {
+	body, resp, err := execute(s.Client, http.MethodGet, fmt.Sprintf("%s/%s", tasksAPIEndpoint, url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read task '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+
+	var task Task
+	if err := json.Unmarshal(body, &task); err != nil {
+		return nil, fmt.Errorf("could not unmarshal task '%s': %v", id, err)
+	}
+	return &task, nil
+}
+
+// List returns all tasks, following the continuation tokens of the
+// paginated API
+func (s *TaskService) List() ([]Task, error) {
+	tasks := []Task{}
+	continuationToken := ""
+
+	for {
+		endpoint := tasksAPIEndpoint
+		if continuationToken != "" {
+			endpoint = fmt.Sprintf("%s?continuationToken=%s", tasksAPIEndpoint, url.QueryEscape(continuationToken))
+		}
+
+		body, resp, err := execute(s.Client, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("could not list tasks: HTTP: %d, %s", resp.StatusCode, string(body))
+		}
+
+		var page taskPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("could not unmarshal tasks: %v", err)
+		}
+		tasks = append(tasks, page.Items...)
+
+		if page.ContinuationToken == "" {
+			return tasks, nil
+		}
+		continuationToken = page.ContinuationToken
+	}
+}
+
+func (s *TaskService) Run(id string) error {
+	body, resp, err := execute(s.Client, http.MethodPost, fmt.Sprintf("%s/%s/run", tasksAPIEndpoint, url.PathEscape(id)), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusMethodNotAllowed {
+		return fmt.Errorf("could not run task '%s': the task is disabled", id)
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not run task '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *TaskService) Delete(id string) error {
+	if err := extDirectCall(s.Client, taskExtDirectAction, "remove", []interface{}{id}, nil); err != nil {
+		return fmt.Errorf("could not delete task '%s': %w", id, err)
+	}
+	return nil
+}
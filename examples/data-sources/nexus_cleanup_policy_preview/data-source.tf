@@ -0,0 +1,11 @@
+data "nexus_cleanup_policy_preview" "old_snapshots" {
+  policy     = "delete-old-snapshots"
+  repository = "maven-snapshots"
+
+  lifecycle {
+    postcondition {
+      condition     = self.component_count < 1000
+      error_message = "The cleanup policy would delete ${self.component_count} components."
+    }
+  }
+}
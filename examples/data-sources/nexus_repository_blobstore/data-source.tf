@@ -0,0 +1,7 @@
+data "nexus_repository_blobstore" "releases" {
+  name = "maven-releases"
+}
+
+output "releases_blob_store" {
+  value = data.nexus_repository_blobstore.releases.blob_store_name
+}
@@ -0,0 +1,9 @@
+data "nexus_repository_maven_proxy" "central" {
+  name = "maven-central"
+}
+
+# Add the proxy Nexus created on installation to the default group
+resource "nexus_repository_group_member" "central" {
+  group  = "maven-public"
+  member = data.nexus_repository_maven_proxy.central.name
+}
@@ -0,0 +1,7 @@
+data "nexus_repository_nuget_proxy" "nuget_org" {
+  name = "nuget.org-proxy"
+}
+
+output "nuget_org_protocol_version" {
+  value = data.nexus_repository_nuget_proxy.nuget_org.nuget[0].nuget_version
+}
@@ -0,0 +1,7 @@
+data "nexus_repository_stats" "releases" {
+  repository = "maven-releases"
+}
+
+output "releases_asset_count" {
+  value = data.nexus_repository_stats.releases.asset_count
+}
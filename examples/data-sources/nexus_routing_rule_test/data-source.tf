@@ -0,0 +1,11 @@
+data "nexus_routing_rule_test" "internal_artifact" {
+  rule = nexus_routing_rule.stop_leaks.name
+  path = "/com/example/app/1.0/app-1.0.jar"
+
+  lifecycle {
+    postcondition {
+      condition     = !self.allowed
+      error_message = "The routing rule must block internal artifacts."
+    }
+  }
+}
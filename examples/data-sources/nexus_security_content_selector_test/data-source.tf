@@ -0,0 +1,9 @@
+data "nexus_security_content_selector_test" "example_releases" {
+  expression = nexus_security_content_selector.example.expression
+  format     = "maven2"
+  path       = "/org/example/app/1.0/app-1.0.jar"
+}
+
+output "example_release_selected" {
+  value = data.nexus_security_content_selector_test.example_releases.matched
+}
@@ -1 +1,9 @@
 data "nexus_security_ldap" "default" {}
+
+data "nexus_security_ldap" "corp" {
+  name = "corp"
+}
+
+output "corp_ldap_url" {
+  value = "${data.nexus_security_ldap.corp.protocol}://${data.nexus_security_ldap.corp.host}:${data.nexus_security_ldap.corp.port}/${data.nexus_security_ldap.corp.search_base}"
+}
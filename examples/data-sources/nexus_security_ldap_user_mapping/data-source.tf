@@ -0,0 +1,8 @@
+data "nexus_security_ldap_user_mapping" "jdoe" {
+  ldap_name = nexus_security_ldap.example.name
+  username  = "jdoe"
+}
+
+output "jdoe_groups" {
+  value = data.nexus_security_ldap_user_mapping.jdoe.groups
+}
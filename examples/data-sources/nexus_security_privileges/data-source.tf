@@ -0,0 +1,7 @@
+data "nexus_security_privileges" "application" {
+  type = "application"
+}
+
+output "application_privilege_names" {
+  value = data.nexus_security_privileges.application.privileges[*].name
+}
@@ -0,0 +1,7 @@
+data "nexus_security_user_sources" "all" {}
+
+# List the users of every source except the local users
+data "nexus_security_users" "external" {
+  for_each = toset([for source in data.nexus_security_user_sources.all.sources : source.id if source.id != "default"])
+  source   = each.value
+}
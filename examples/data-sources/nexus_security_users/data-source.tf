@@ -0,0 +1,7 @@
+data "nexus_security_users" "ldap" {
+  source = "LDAP"
+}
+
+output "ldap_userids" {
+  value = data.nexus_security_users.ldap.users[*].userid
+}
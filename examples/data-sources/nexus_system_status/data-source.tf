@@ -0,0 +1,7 @@
+data "nexus_system_status" "nexus" {}
+
+resource "nexus_security_user_token" "token" {
+  count = data.nexus_system_status.nexus.edition == "PRO" ? 1 : 0
+
+  enabled = true
+}
@@ -0,0 +1,2 @@
+# import using the name of the group and the member separated by a slash
+terraform import nexus_repository_group_member.team_a yum-group/team-a-yum-hosted
@@ -0,0 +1,28 @@
+resource "nexus_repository_yum_group" "group" {
+  name   = "yum-group"
+  online = true
+
+  group {
+    member_names = ["yum-hosted"]
+  }
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  lifecycle {
+    # Members are managed by nexus_repository_group_member
+    ignore_changes = [group]
+  }
+}
+
+resource "nexus_repository_group_member" "team_a" {
+  group  = nexus_repository_yum_group.group.name
+  member = "team-a-yum-hosted"
+}
+
+resource "nexus_repository_group_member" "team_b" {
+  group  = nexus_repository_yum_group.group.name
+  member = "team-b-yum-hosted"
+}
@@ -0,0 +1,8 @@
+resource "nexus_repository_invalidate_cache" "maven_central" {
+  repository = "maven-central"
+
+  # Invalidate the cache again whenever the upstream release changes
+  triggers = {
+    release = var.upstream_release
+  }
+}
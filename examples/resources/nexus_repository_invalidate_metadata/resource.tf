@@ -0,0 +1,8 @@
+resource "nexus_repository_invalidate_metadata" "maven_central" {
+  repository = "maven-central"
+
+  # Fetch maven-metadata.xml again whenever a new upstream release is expected
+  triggers = {
+    release = var.upstream_release
+  }
+}
@@ -0,0 +1,2 @@
+# import using the name of repository
+terraform import nexus_repository_maven_proxy.maven_central maven-central-repo1
@@ -0,0 +1,8 @@
+resource "nexus_repository_move" "maven_releases" {
+  repository       = "maven-releases"
+  target_blobstore = nexus_blobstore_s3.artifacts.name
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,30 @@
+resource "nexus_repository_npm_proxy" "npmjs" {
+  name   = "npmjs"
+  online = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy {
+    remote_url       = "https://registry.npmjs.org"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client {
+    blocked    = false
+    auto_block = true
+  }
+
+  npm {
+    remove_non_cataloged = false
+    remove_quarantined   = true
+  }
+}
@@ -0,0 +1,2 @@
+# import using the name of repository
+terraform import nexus_repository_pypi_hosted.internal pypi-internal
@@ -0,0 +1,29 @@
+resource "nexus_repository_raw_proxy" "nodejs" {
+  name   = "nodejs"
+  online = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = false
+  }
+
+  proxy {
+    remote_url       = "https://nodejs.org/dist/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache {
+    enabled      = true
+    time_to_live = 1440
+  }
+
+  http_client {
+    blocked    = false
+    auto_block = true
+  }
+
+  raw {
+    content_disposition = "ATTACHMENT"
+  }
+}
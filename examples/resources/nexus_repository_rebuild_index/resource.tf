@@ -0,0 +1,12 @@
+resource "nexus_repository_rebuild_index" "maven_releases" {
+  repository = "maven-releases"
+
+  # Rebuild the index again after every bulk import
+  triggers = {
+    import = var.import_batch
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,2 @@
+# import using the id of the replication connection
+terraform import nexus_repository_replication.maven_releases 7a1c2f5e-3d4b-4f6a-9c8e-0b1d2e3f4a5b
@@ -0,0 +1,9 @@
+resource "nexus_repository_replication" "maven_releases" {
+  name              = "maven-releases-to-replica"
+  source_repository = "maven-releases"
+  target_url        = "https://nexus-replica.example.com"
+  username          = "replicator"
+  password          = var.replicator_password
+
+  include_existing_content = true
+}
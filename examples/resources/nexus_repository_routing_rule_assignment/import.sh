@@ -0,0 +1,2 @@
+# import using the name of the proxy repository and the routing rule separated by a slash
+terraform import nexus_repository_routing_rule_assignment.npmjs npmjs/block-internal
@@ -0,0 +1,40 @@
+resource "nexus_routing_rule" "block_internal" {
+  name        = "block-internal"
+  description = "Block requests for internal packages"
+  mode        = "BLOCK"
+  matchers = [
+    "^/@acme/.*",
+  ]
+}
+
+resource "nexus_repository_npm_proxy" "npmjs" {
+  name   = "npmjs"
+  online = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy {
+    remote_url = "https://registry.npmjs.org"
+  }
+
+  negative_cache {
+    enabled = true
+  }
+
+  http_client {
+    auto_block = true
+  }
+
+  lifecycle {
+    # The routing rule is managed by nexus_repository_routing_rule_assignment
+    ignore_changes = [routing_rule]
+  }
+}
+
+resource "nexus_repository_routing_rule_assignment" "npmjs" {
+  repository   = nexus_repository_npm_proxy.npmjs.name
+  routing_rule = nexus_routing_rule.block_internal.name
+}
@@ -0,0 +1,13 @@
+resource "nexus_scheduled_task_run" "rebuild_index" {
+  task        = "Rebuild maven-releases search index"
+  on_conflict = "WAIT"
+
+  # Run the task again for every release
+  triggers = {
+    release = var.release_version
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
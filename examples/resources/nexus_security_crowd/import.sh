@@ -0,0 +1,2 @@
+# import the Crowd configuration, there is only one
+terraform import nexus_security_crowd.crowd crowd
@@ -0,0 +1,6 @@
+resource "nexus_security_crowd" "crowd" {
+  url                  = "https://crowd.example.com/crowd"
+  application_name     = "nexus"
+  application_password = var.crowd_application_password
+  timeout              = 30
+}
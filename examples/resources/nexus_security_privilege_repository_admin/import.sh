@@ -0,0 +1,2 @@
+# import using the name of the privilege
+terraform import nexus_security_privilege_repository_admin.example docker-hosted-admin
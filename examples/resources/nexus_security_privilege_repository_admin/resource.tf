@@ -0,0 +1,13 @@
+resource "nexus_security_privilege_repository_admin" "example" {
+  name        = "docker-hosted-admin"
+  description = "Configure the docker-hosted repository"
+  format      = "docker"
+  repository  = "docker-hosted"
+  actions     = ["BROWSE", "READ", "EDIT"]
+}
+
+resource "nexus_security_role" "docker_admin" {
+  roleid     = "docker-admin"
+  name       = "docker-admin"
+  privileges = [nexus_security_privilege_repository_admin.example.name]
+}
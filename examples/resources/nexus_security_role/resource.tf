@@ -15,4 +15,14 @@ resource "nexus_security_role" "docker_deploy" {
     "nx-repository-view-docker-*-*",
   ]
   roleid = "docker-deploy"
-}
\ No newline at end of file
+}
+# Example Usage - Attach privileges to a role which is managed in the UI
+# Privileges and roles which are not declared here are left untouched
+resource "nexus_security_role" "developers" {
+  roleid    = "developers"
+  name      = "developers"
+  exclusive = false
+  privileges = [
+    "nx-repository-view-maven2-*-read",
+  ]
+}
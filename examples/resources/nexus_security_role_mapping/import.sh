@@ -0,0 +1,2 @@
+# import using the source and the id of the external role
+terraform import nexus_security_role_mapping.developers LDAP/developers
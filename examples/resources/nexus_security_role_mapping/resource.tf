@@ -0,0 +1,8 @@
+# Grant the members of the LDAP group "developers" read access to all
+# repositories
+resource "nexus_security_role_mapping" "developers" {
+  source           = "LDAP"
+  external_role_id = "developers"
+  description      = "Members of the LDAP group developers"
+  privileges       = ["nx-repository-view-*-*-read", "nx-repository-view-*-*-browse"]
+}
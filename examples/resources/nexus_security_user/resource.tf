@@ -7,3 +7,21 @@ resource "nexus_security_user" "admin" {
   roles     = ["nx-admin"]
   status    = "active"
 }
+
+# Bump the rotation to generate and set a new password of the service account
+resource "random_password" "deployer" {
+  length = 32
+  keepers = {
+    rotation = "2022-06"
+  }
+}
+
+resource "nexus_security_user" "deployer" {
+  userid                  = "deployer"
+  firstname               = "Deployer"
+  lastname                = "CI"
+  email                   = "deployer@example.com"
+  password                = random_password.deployer.result
+  password_rotate_trigger = random_password.deployer.keepers.rotation
+  roles                   = ["nx-deploy"]
+}
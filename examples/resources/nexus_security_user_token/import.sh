@@ -1,2 +1,3 @@
-# import of the global user token configuration
-terraform import nexus_security_user_token.nexus golbalUserTokenConfiguration
+# import of the global user token configuration, which always has the ID
+# globalUserTokenConfiguration
+terraform import nexus_security_user_token.nexus globalUserTokenConfiguration
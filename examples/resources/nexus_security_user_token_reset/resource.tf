@@ -0,0 +1,7 @@
+resource "nexus_security_user_token_reset" "offboarding" {
+  user_id = "jdoe"
+
+  triggers = {
+    offboarded_at = "2022-06-01"
+  }
+}
@@ -0,0 +1,2 @@
+# import the base URL of Nexus
+terraform import nexus_system_baseurl.this baseurl
@@ -0,0 +1,3 @@
+resource "nexus_system_baseurl" "this" {
+  base_url = "https://nexus.example.com"
+}
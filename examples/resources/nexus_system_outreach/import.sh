@@ -0,0 +1,2 @@
+# import the outreach configuration of Nexus
+terraform import nexus_system_outreach.this OutreachManagementCapability
@@ -0,0 +1,3 @@
+resource "nexus_system_outreach" "this" {
+  enabled = false
+}
@@ -0,0 +1,8 @@
+resource "nexus_system_smtp_test" "admins" {
+  to_address = "nexus-admins@example.com"
+
+  triggers = {
+    # Send another test email when the email server changes
+    smtp_host = var.smtp_host
+  }
+}
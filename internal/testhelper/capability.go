@@ -0,0 +1,82 @@
+// Package testhelper contains fakes of Nexus APIs which are shared by the
+// unit tests of several services
+package testhelper
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+)
+
+// FakeCapabilityServer serves the capability calls of the ExtDirect API from
+// the given capabilities
+func FakeCapabilityServer(capabilities *[]api.Capability) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/service/extdirect" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var request struct {
+			Action string            `json:"action"`
+			Method string            `json:"method"`
+			Data   []json.RawMessage `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Action != "capability_Capability" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var result interface{}
+		switch request.Method {
+		case "read":
+			result = *capabilities
+		case "create":
+			var capability api.Capability
+			if err := json.Unmarshal(request.Data[0], &capability); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			capability.ID = fmt.Sprintf("capability-%d", len(*capabilities)+1)
+			*capabilities = append(*capabilities, capability)
+			result = capability
+		case "update":
+			var capability api.Capability
+			if err := json.Unmarshal(request.Data[0], &capability); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			for i := range *capabilities {
+				if (*capabilities)[i].ID == capability.ID {
+					(*capabilities)[i] = capability
+				}
+			}
+		case "remove":
+			var id string
+			if err := json.Unmarshal(request.Data[0], &id); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			remaining := []api.Capability{}
+			for _, capability := range *capabilities {
+				if capability.ID != id {
+					remaining = append(remaining, capability)
+				}
+			}
+			*capabilities = remaining
+		}
+
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"type":   "rpc",
+			"tid":    1,
+			"action": request.Action,
+			"method": request.Method,
+			"result": map[string]interface{}{"success": true, "data": result},
+		}); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+}
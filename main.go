@@ -12,12 +12,17 @@ import (
 // Generate docs for website
 //go:generate go run github.com/datadrivers/terraform-plugin-docs/cmd/tfplugindocs
 
+// version is set at build time by goreleaser
+var version = "dev"
+
 func main() {
 	var debugMode bool
 
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	provider.Version = version
+
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/datadrivers/nexus",
 			&plugin.ServeOpts{
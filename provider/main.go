@@ -1,82 +1,129 @@
 package provider
 
 import (
+	"regexp"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/services/blobstore"
 	"github.com/SimCubeLtd/terraform-provider-nexus/services/deprecated"
 	"github.com/SimCubeLtd/terraform-provider-nexus/services/other"
 	"github.com/SimCubeLtd/terraform-provider-nexus/services/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/services/security"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Version of the provider, set by main at startup
+var Version = "dev"
+
 // Provider returns a terraform.Provider
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
-			"nexus_anonymous":                 deprecated.DataSourceAnonymous(),
-			"nexus_blobstore":                 deprecated.DataSourceBlobstore(),
-			"nexus_blobstore_azure":           blobstore.DataSourceBlobstoreAzure(),
-			"nexus_blobstore_file":            blobstore.DataSourceBlobstoreFile(),
-			"nexus_blobstore_group":           blobstore.DataSourceBlobstoreGroup(),
-			"nexus_blobstore_s3":              blobstore.DataSourceBlobstoreS3(),
-			"nexus_privileges":                deprecated.DataSourcePrivileges(),
-			"nexus_repository":                deprecated.DataSourceRepository(),
-			"nexus_repository_apt_hosted":     repository.DataSourceRepositoryAptHosted(),
-			"nexus_repository_apt_proxy":      repository.DataSourceRepositoryAptProxy(),
-			"nexus_repository_docker_group":   repository.DataSourceRepositoryDockerGroup(),
-			"nexus_repository_docker_hosted":  repository.DataSourceRepositoryDockerHosted(),
-			"nexus_repository_docker_proxy":   repository.DataSourceRepositoryDockerProxy(),
-			"nexus_repository_list":           repository.DataSourceRepositoryList(),
-			"nexus_repository_yum_group":      repository.DataSourceRepositoryYumGroup(),
-			"nexus_repository_yum_hosted":     repository.DataSourceRepositoryYumHosted(),
-			"nexus_repository_yum_proxy":      repository.DataSourceRepositoryYumProxy(),
-			"nexus_routing_rule":              other.DataSourceRoutingRule(),
-			"nexus_security_anonymous":        security.DataSourceSecurityAnonymous(),
-			"nexus_security_content_selector": security.DataSourceSecurityContentSelector(),
-			"nexus_security_ldap":             security.DataSourceSecurityLDAP(),
-			"nexus_security_realms":           security.DataSourceSecurityRealms(),
-			"nexus_security_role":             security.DataSourceSecurityRole(),
-			"nexus_security_saml":             security.DataSourceSecuritySAML(),
-			"nexus_security_user":             security.DataSourceSecurityUser(),
-			"nexus_security_user_token":       security.DataSourceSecurityUserToken(),
-			"nexus_user":                      deprecated.DataSourceUser(),
+			"nexus_anonymous":                      deprecated.DataSourceAnonymous(),
+			"nexus_blobstore":                      blobstore.DataSourceBlobstore(),
+			"nexus_blobstore_azure":                blobstore.DataSourceBlobstoreAzure(),
+			"nexus_blobstore_file":                 blobstore.DataSourceBlobstoreFile(),
+			"nexus_blobstore_group":                blobstore.DataSourceBlobstoreGroup(),
+			"nexus_blobstore_s3":                   blobstore.DataSourceBlobstoreS3(),
+			"nexus_cleanup_policy_preview":         other.DataSourceCleanupPolicyPreview(),
+			"nexus_privileges":                     deprecated.DataSourcePrivileges(),
+			"nexus_repository":                     deprecated.DataSourceRepository(),
+			"nexus_repository_apt_hosted":          repository.DataSourceRepositoryAptHosted(),
+			"nexus_repository_apt_proxy":           repository.DataSourceRepositoryAptProxy(),
+			"nexus_repository_blobstore":           repository.DataSourceRepositoryBlobstore(),
+			"nexus_repository_docker_group":        repository.DataSourceRepositoryDockerGroup(),
+			"nexus_repository_docker_hosted":       repository.DataSourceRepositoryDockerHosted(),
+			"nexus_repository_docker_proxy":        repository.DataSourceRepositoryDockerProxy(),
+			"nexus_repository_list":                repository.DataSourceRepositoryList(),
+			"nexus_repository_maven_proxy":         repository.DataSourceRepositoryMavenProxy(),
+			"nexus_repository_nuget_proxy":         repository.DataSourceRepositoryNugetProxy(),
+			"nexus_repository_stats":               repository.DataSourceRepositoryStats(),
+			"nexus_repository_yum_group":           repository.DataSourceRepositoryYumGroup(),
+			"nexus_repository_yum_hosted":          repository.DataSourceRepositoryYumHosted(),
+			"nexus_repository_yum_proxy":           repository.DataSourceRepositoryYumProxy(),
+			"nexus_routing_rule":                   other.DataSourceRoutingRule(),
+			"nexus_routing_rule_test":              other.DataSourceRoutingRuleTest(),
+			"nexus_security_anonymous":             security.DataSourceSecurityAnonymous(),
+			"nexus_security_content_selector":      security.DataSourceSecurityContentSelector(),
+			"nexus_security_content_selector_test": security.DataSourceSecurityContentSelectorTest(),
+			"nexus_security_ldap":                  security.DataSourceSecurityLDAP(),
+			"nexus_security_ldap_user_mapping":     security.DataSourceSecurityLDAPUserMapping(),
+			"nexus_security_privileges":            security.DataSourceSecurityPrivileges(),
+			"nexus_security_realms":                security.DataSourceSecurityRealms(),
+			"nexus_security_role":                  security.DataSourceSecurityRole(),
+			"nexus_security_saml":                  security.DataSourceSecuritySAML(),
+			"nexus_security_user":                  security.DataSourceSecurityUser(),
+			"nexus_security_user_sources":          security.DataSourceSecurityUserSources(),
+			"nexus_security_user_token":            security.DataSourceSecurityUserToken(),
+			"nexus_security_users":                 security.DataSourceSecurityUsers(),
+			"nexus_system_status":                  other.DataSourceSystemStatus(),
+			"nexus_user":                           deprecated.DataSourceUser(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"nexus_anonymous":                 deprecated.ResourceAnonymous(),
-			"nexus_blobstore":                 deprecated.ResourceBlobstore(),
-			"nexus_blobstore_azure":           blobstore.ResourceBlobstoreAzure(),
-			"nexus_blobstore_file":            blobstore.ResourceBlobstoreFile(),
-			"nexus_blobstore_group":           blobstore.ResourceBlobstoreGroup(),
-			"nexus_blobstore_s3":              blobstore.ResourceBlobstoreS3(),
-			"nexus_content_selector":          deprecated.ResourceContentSelector(),
-			"nexus_privilege":                 deprecated.ResourcePrivilege(),
-			"nexus_repository":                deprecated.ResourceRepository(),
-			"nexus_repository_apt_hosted":     repository.ResourceRepositoryAptHosted(),
-			"nexus_repository_apt_proxy":      repository.ResourceRepositoryAptProxy(),
-			"nexus_repository_docker_group":   repository.ResourceRepositoryDockerGroup(),
-			"nexus_repository_docker_hosted":  repository.ResourceRepositoryDockerHosted(),
-			"nexus_repository_docker_proxy":   repository.ResourceRepositoryDockerProxy(),
-			"nexus_repository_maven_hosted":   repository.ResourceRepositoryMavenHosted(),
-			"nexus_repository_yum_group":      repository.ResourceRepositoryYumGroup(),
-			"nexus_repository_yum_hosted":     repository.ResourceRepositoryYumHosted(),
-			"nexus_repository_yum_proxy":      repository.ResourceRepositoryYumProxy(),
-			"nexus_role":                      deprecated.ResourceRole(),
-			"nexus_routing_rule":              other.ResourceRoutingRule(),
-			"nexus_script":                    other.ResourceScript(),
-			"nexus_security_anonymous":        security.ResourceSecurityAnonymous(),
-			"nexus_security_content_selector": security.ResourceSecurityContentSelector(),
-			"nexus_security_ldap":             security.ResourceSecurityLDAP(),
-			"nexus_security_ldap_order":       security.ResourceSecurityLDAPOrder(),
-			"nexus_security_realms":           security.ResourceSecurityRealms(),
-			"nexus_security_role":             security.ResourceSecurityRole(),
-			"nexus_security_saml":             security.ResourceSecuritySAML(),
-			"nexus_security_user":             security.ResourceSecurityUser(),
-			"nexus_security_user_token":       security.ResourceSecurityUserToken(),
-			"nexus_user":                      deprecated.ResourceUser(),
+			"nexus_anonymous":                           deprecated.ResourceAnonymous(),
+			"nexus_blobstore":                           deprecated.ResourceBlobstore(),
+			"nexus_blobstore_azure":                     blobstore.ResourceBlobstoreAzure(),
+			"nexus_blobstore_file":                      blobstore.ResourceBlobstoreFile(),
+			"nexus_blobstore_group":                     blobstore.ResourceBlobstoreGroup(),
+			"nexus_blobstore_s3":                        blobstore.ResourceBlobstoreS3(),
+			"nexus_content_selector":                    deprecated.ResourceContentSelector(),
+			"nexus_privilege":                           deprecated.ResourcePrivilege(),
+			"nexus_repository":                          deprecated.ResourceRepository(),
+			"nexus_repository_apt_hosted":               repository.ResourceRepositoryAptHosted(),
+			"nexus_repository_apt_proxy":                repository.ResourceRepositoryAptProxy(),
+			"nexus_repository_docker_group":             repository.ResourceRepositoryDockerGroup(),
+			"nexus_repository_docker_hosted":            repository.ResourceRepositoryDockerHosted(),
+			"nexus_repository_docker_proxy":             repository.ResourceRepositoryDockerProxy(),
+			"nexus_repository_group_member":             repository.ResourceRepositoryGroupMember(),
+			"nexus_repository_invalidate_cache":         repository.ResourceRepositoryInvalidateCache(),
+			"nexus_repository_invalidate_metadata":      repository.ResourceRepositoryInvalidateMetadata(),
+			"nexus_repository_maven_group":              repository.ResourceRepositoryMavenGroup(),
+			"nexus_repository_maven_hosted":             repository.ResourceRepositoryMavenHosted(),
+			"nexus_repository_maven_proxy":              repository.ResourceRepositoryMavenProxy(),
+			"nexus_repository_move":                     repository.ResourceRepositoryMove(),
+			"nexus_repository_npm_hosted":               repository.ResourceRepositoryNpmHosted(),
+			"nexus_repository_npm_proxy":                repository.ResourceRepositoryNpmProxy(),
+			"nexus_repository_pypi_hosted":              repository.ResourceRepositoryPypiHosted(),
+			"nexus_repository_pypi_proxy":               repository.ResourceRepositoryPypiProxy(),
+			"nexus_repository_rebuild_index":            repository.ResourceRepositoryRebuildIndex(),
+			"nexus_repository_replication":              repository.ResourceRepositoryReplication(),
+			"nexus_repository_routing_rule_assignment":  repository.ResourceRepositoryRoutingRuleAssignment(),
+			"nexus_repository_raw_hosted":               repository.ResourceRepositoryRawHosted(),
+			"nexus_repository_raw_proxy":                repository.ResourceRepositoryRawProxy(),
+			"nexus_repository_yum_group":                repository.ResourceRepositoryYumGroup(),
+			"nexus_repository_yum_hosted":               repository.ResourceRepositoryYumHosted(),
+			"nexus_repository_yum_proxy":                repository.ResourceRepositoryYumProxy(),
+			"nexus_role":                                deprecated.ResourceRole(),
+			"nexus_routing_rule":                        other.ResourceRoutingRule(),
+			"nexus_script":                              other.ResourceScript(),
+			"nexus_scheduled_task_run":                  other.ResourceScheduledTaskRun(),
+			"nexus_security_anonymous":                  security.ResourceSecurityAnonymous(),
+			"nexus_security_content_selector":           security.ResourceSecurityContentSelector(),
+			"nexus_security_crowd":                      security.ResourceSecurityCrowd(),
+			"nexus_security_ldap":                       security.ResourceSecurityLDAP(),
+			"nexus_security_ldap_order":                 security.ResourceSecurityLDAPOrder(),
+			"nexus_security_privilege_repository_admin": security.ResourceSecurityPrivilegeRepositoryAdmin(),
+			"nexus_security_realms":                     security.ResourceSecurityRealms(),
+			"nexus_security_role":                       security.ResourceSecurityRole(),
+			"nexus_security_role_mapping":               security.ResourceSecurityRoleMapping(),
+			"nexus_security_saml":                       security.ResourceSecuritySAML(),
+			"nexus_security_user":                       security.ResourceSecurityUser(),
+			"nexus_security_user_token":                 security.ResourceSecurityUserToken(),
+			"nexus_security_user_token_reset":           security.ResourceSecurityUserTokenReset(),
+			"nexus_system_baseurl":                      other.ResourceSystemBaseURL(),
+			"nexus_system_outreach":                     other.ResourceSystemOutreach(),
+			"nexus_system_smtp_test":                    other.ResourceSystemSMTPTest(),
+			"nexus_user":                                deprecated.ResourceUser(),
 		},
 		Schema: map[string]*schema.Schema{
+			"debug_logging": {
+				Default:     false,
+				Description: "Log every API request with its method, path and status at debug level, see `TF_LOG`. Credentials, query parameters and bodies are never logged. Requests sent through go-nexus-client, which handles the basic repository and security calls, are not logged. Default:`false`",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			"insecure": {
 				Description: "Boolean to specify wether insecure SSL connections are allowed or not. Reading environment variable NEXUS_INSECURE_SKIP_VERIFY. Default:`true`",
 				Default:     false,
@@ -84,18 +131,50 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Type:        schema.TypeBool,
 			},
+			"max_concurrent_requests": {
+				Default:      10,
+				Description:  "Maximum number of API requests sent to Nexus at the same time. Terraform applies resources in parallel (see `terraform apply -parallelism`), raising this value speeds up large applies at the cost of more load on Nexus. Each create, read, update and delete of a repository resource holds one slot while it sends its requests. Other requests sent through go-nexus-client, e.g. of security and blob store resources, are not limited. Default:`10`",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"nexus_version": {
+				Description:  "Version of Nexus, e.g. `3.38.1`. Fields which need a newer Nexus fail at plan time with a friendly error instead of a server error. Resources which use the internal API of the Nexus UI, e.g. `nexus_system_baseurl`, fail with a friendly error on versions other than `3.37` up to `3.x`. Detected via the status endpoint if not set.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+\.\d+(\.\d+)?(-\d+)?$`), "nexus_version should be in the format '3.38.1'"),
+			},
 			"password": {
 				Description: "Password of user to connect to API. Reading environment variable NEXUS_PASSWORD. Default:`admin123`",
 				DefaultFunc: schema.EnvDefaultFunc("NEXUS_PASSWORD", "admin123"),
 				Required:    true,
 				Type:        schema.TypeString,
 			},
+			"retries": {
+				Default:      api.DefaultRetries,
+				Description:  "How often requests which may fail while a Nexus cluster propagates a change are retried with backoff, e.g. creating a repository on a blob store which is not yet known to every node. Default:`3`",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"skip_connectivity_check": {
+				Description: "Skip the request which verifies URL, TLS settings and credentials when the provider is configured. Reading environment variable NEXUS_SKIP_CONNECTIVITY_CHECK. Default:`false`",
+				DefaultFunc: schema.EnvDefaultFunc("NEXUS_SKIP_CONNECTIVITY_CHECK", false),
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			"url": {
 				Description: "URL of Nexus to reach API. Reading environment variable NEXUS_URL. Default:`http://127.0.0.1:8080`",
 				DefaultFunc: schema.EnvDefaultFunc("NEXUS_URL", "http://127.0.0.1:8080"),
 				Required:    true,
 				Type:        schema.TypeString,
 			},
+			"user_agent": {
+				Description: "Custom fragment appended to the User-Agent header of API requests, e.g. `terraform-provider-nexus/<version> (<user_agent>)`. Requests sent through go-nexus-client, which handles the basic repository and security calls, keep its default User-Agent. Reading environment variable NEXUS_USER_AGENT.",
+				DefaultFunc: schema.EnvDefaultFunc("NEXUS_USER_AGENT", ""),
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
 			"username": {
 				Description: "Username used to connect to API. Reading environment variable NEXUS_USERNAME. Default:`admin`",
 				DefaultFunc: schema.EnvDefaultFunc("NEXUS_USERNAME", "admin"),
@@ -115,5 +194,33 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Username: d.Get("username").(string),
 	}
 
-	return nexus.NewClient(config), nil
+	requestLimit := api.NewRequestLimit(d.Get("max_concurrent_requests").(int))
+	httpClient := api.NewHTTPClient(config)
+	transport := httpClient.Transport
+	if d.Get("debug_logging").(bool) {
+		transport = &loggingTransport{next: transport}
+	}
+	httpClient.Transport = &userAgentTransport{
+		next:      &limitTransport{next: transport, limit: requestLimit},
+		userAgent: userAgent(d.Get("user_agent").(string)),
+	}
+
+	nexusClient := api.NewClient(config, httpClient)
+
+	api.SetRequestLimit(nexusClient, requestLimit)
+	api.SetRetries(nexusClient, d.Get("retries").(int))
+
+	if !d.Get("skip_connectivity_check").(bool) {
+		if err := api.CheckConnectivity(nexusClient); err != nil {
+			return nil, err
+		}
+	}
+
+	if nexusVersion, ok := d.GetOk("nexus_version"); ok {
+		if err := api.PinServerVersion(nexusClient, nexusVersion.(string)); err != nil {
+			return nil, err
+		}
+	}
+
+	return nexusClient, nil
 }
@@ -1,9 +1,20 @@
 package provider
 
 import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestProvider(t *testing.T) {
@@ -15,3 +26,171 @@ func TestProvider(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
+
+func TestProviderUserAgent(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	for _, custom := range []string{"", "acceptance"} {
+		resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"password":                "admin123",
+			"skip_connectivity_check": true,
+			"url":                     server.URL,
+			"user_agent":              custom,
+			"username":                "admin",
+		})
+		m, err := providerConfigure(resourceData)
+		assert.Nil(t, err)
+
+		err = api.CheckConnectivity(m.(*api.Client))
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, []string{
+		fmt.Sprintf("terraform-provider-nexus/%s", Version),
+		fmt.Sprintf("terraform-provider-nexus/%s (acceptance)", Version),
+	}, userAgents)
+}
+
+func TestProviderMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"max_concurrent_requests": 2,
+		"password":                "admin123",
+		"url":                     server.URL,
+		"username":                "admin",
+	})
+	m, err := providerConfigure(resourceData)
+	assert.Nil(t, err)
+	client := m.(*api.Client)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := api.CheckConnectivity(client)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+}
+
+func TestProviderNexusVersion(t *testing.T) {
+	var statusRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&statusRequests, 1)
+		w.Header().Set("Server", "Nexus/3.38.1-01 (OSS)")
+	}))
+	defer server.Close()
+
+	resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"nexus_version":           "3.37.3",
+		"password":                "admin123",
+		"skip_connectivity_check": true,
+		"url":                     server.URL,
+		"username":                "admin",
+	})
+	m, err := providerConfigure(resourceData)
+	assert.Nil(t, err)
+
+	// The pinned version wins over the version Nexus reports
+	err = api.RequireServerVersion(m.(*api.Client), "docker.0.subdomain", "3.38")
+	assert.EqualError(t, err, "docker.0.subdomain requires Nexus >= 3.38, but Nexus 3.37.3 is used")
+	assert.Nil(t, api.RequireServerVersion(m.(*api.Client), "cleanup", "3.37"))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&statusRequests))
+
+	_, errs := Provider().Schema["nexus_version"].ValidateFunc("latest", "nexus_version")
+	assert.Len(t, errs, 1)
+}
+
+func TestProviderDebugLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	for _, debugLogging := range []bool{false, true} {
+		resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"debug_logging": debugLogging,
+			"password":      "s3cr3t-password",
+			"url":           server.URL,
+			"username":      "admin",
+		})
+		m, err := providerConfigure(resourceData)
+		assert.Nil(t, err)
+
+		err = api.CheckConnectivity(m.(*api.Client))
+		assert.Nil(t, err)
+
+		if !debugLogging {
+			assert.Empty(t, output.String())
+		}
+	}
+
+	assert.Contains(t, output.String(), "[DEBUG] Nexus API GET /service/rest/v1/status/check: 200 OK")
+	assert.NotContains(t, output.String(), "s3cr3t-password")
+	assert.NotContains(t, output.String(), "Basic")
+}
+
+func TestProviderConnectivityCheck(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch {
+		case r.URL.Path != "/service/rest/v1/status/check":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			username, _, _ := r.BasicAuth()
+			if username != "admin" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer server.Close()
+
+	configure := func(username string, url string, skip bool) error {
+		resourceData := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"password":                "admin123",
+			"skip_connectivity_check": skip,
+			"url":                     url,
+			"username":                username,
+		})
+		_, err := providerConfigure(resourceData)
+		return err
+	}
+
+	assert.NoError(t, configure("admin", server.URL, false))
+	assert.EqualError(t, configure("nobody", server.URL, false), fmt.Sprintf("cannot reach Nexus at %s: authentication failed, check username and password: HTTP: 401", server.URL))
+	assert.EqualError(t, configure("admin", server.URL+"/nexus", false), fmt.Sprintf("cannot reach Nexus at %s/nexus: the URL does not point to Nexus, check the url and its path: HTTP: 404", server.URL))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+
+	assert.NoError(t, configure("nobody", server.URL, true))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
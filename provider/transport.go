@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+)
+
+// userAgentTransport sets the User-Agent header of every request before
+// passing it on to the next transport
+type userAgentTransport struct {
+	next      http.RoundTripper
+	userAgent string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	return t.next.RoundTrip(req)
+}
+
+func userAgent(custom string) string {
+	if custom == "" {
+		return fmt.Sprintf("terraform-provider-nexus/%s", Version)
+	}
+	return fmt.Sprintf("terraform-provider-nexus/%s (%s)", Version, custom)
+}
+
+// limitTransport makes every request wait for a free slot of the limit, unless
+// its context already holds one. The slot is freed once the body of the
+// response has been closed, as the connection is in use until then.
+type limitTransport struct {
+	next  http.RoundTripper
+	limit *api.RequestLimit
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	_, release, err := t.limit.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody frees the slot of a limitTransport once it has been closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+// loggingTransport logs every request and its outcome at debug level. Only
+// the method, the path and the status are logged, never headers, query
+// parameters or bodies, as they contain credentials and secrets.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		log.Printf("[DEBUG] Nexus API %s %s failed after %s", req.Method, req.URL.Path, time.Since(start))
+		return resp, err
+	}
+	log.Printf("[DEBUG] Nexus API %s %s: %s in %s", req.Method, req.URL.Path, resp.Status, time.Since(start))
+	return resp, nil
+}
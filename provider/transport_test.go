@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestLimitTransport(t *testing.T) {
+	var fail bool
+	transport := &limitTransport{
+		next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if fail {
+				return nil, errors.New("connection refused")
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+		}),
+		limit: api.NewRequestLimit(1),
+	}
+	roundTrip := func(ctx context.Context) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://nexus/service/rest/v1/status", nil)
+		assert.NoError(t, err)
+		return transport.RoundTrip(req)
+	}
+	roundTripWithTimeout := func() (*http.Response, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		return roundTrip(ctx)
+	}
+
+	// The connection is in use until the body has been read and closed
+	resp, err := roundTrip(context.Background())
+	assert.NoError(t, err)
+	_, err = roundTripWithTimeout()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.NoError(t, resp.Body.Close())
+	resp, err = roundTripWithTimeout()
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+
+	// A failed request frees its slot right away
+	fail = true
+	_, err = roundTrip(context.Background())
+	assert.EqualError(t, err, "connection refused")
+	fail = false
+	resp, err = roundTripWithTimeout()
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+
+	// The requests of an operation which holds the slot don't wait for
+	// another one
+	ctx, release, err := transport.limit.Acquire(context.Background())
+	assert.NoError(t, err)
+	defer release()
+	resp, err = roundTrip(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	_, err = roundTripWithTimeout()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -7,9 +7,14 @@ import (
 var (
 	ResourceName = &schema.Schema{
 		Description: "Blobstore name",
+		ForceNew:    true,
 		Required:    true,
 		Type:        schema.TypeString,
 	}
 
-	DataSourceName = ResourceName
+	DataSourceName = &schema.Schema{
+		Description: "Blobstore name",
+		Required:    true,
+		Type:        schema.TypeString,
+	}
 )
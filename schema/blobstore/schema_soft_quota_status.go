@@ -0,0 +1,29 @@
+package blobstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceSoftQuotaStatus = &schema.Schema{
+		Description: "Current status of the soft quota of the blobstore",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"is_violation": {
+					Description: "Whether the blobstore currently exceeds its soft quota",
+					Type:        schema.TypeBool,
+					Computed:    true,
+				},
+				"message": {
+					Description: "The quota status message reported by Nexus",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+		Computed: true,
+		Type:     schema.TypeList,
+	}
+
+	DataSourceSoftQuotaStatus = ResourceSoftQuotaStatus
+)
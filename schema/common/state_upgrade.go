@@ -0,0 +1,12 @@
+package common
+
+import (
+	"context"
+)
+
+// IdentityStateUpgrade keeps the state of a resource as it is. It upgrades
+// from a schema version whose states are valid for the next version as they
+// are, e.g. because the next version only added computed attributes.
+func IdentityStateUpgrade(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return rawState, nil
+}
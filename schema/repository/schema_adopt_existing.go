@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceAdoptExisting = &schema.Schema{
+		Description: "Take over an existing repository of the same name, format and type instead of failing because it already exists, e.g. when bringing an existing Nexus under Terraform. The repository is only read, the next plan shows where it differs from the configuration. Destroying the resource deletes the adopted repository as well, so combine it with `deletion_protection` for repositories which must survive. Defaults to `false`",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+)
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceDeletionProtection = &schema.Schema{
+		Description: "Refuse to delete the repository, e.g. for critical repositories like `maven-central`. Unlike the `prevent_destroy` lifecycle argument it can be set from a variable. Set it to `false` and apply before destroying the repository. Defaults to `false`",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+)
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -13,20 +14,105 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"force_basic_auth": {
-					Description: "Whether to force authentication (Docker Bearer Token Realm required if false)",
+					Default:     true,
+					Description: "Whether to force authentication. Set to false to allow anonymous pulls, which also needs the Docker Bearer Token Realm to be active, see `nexus_security_realms`, and anonymous access to be enabled, see `nexus_security_anonymous`. Default:`true`",
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+				"http_port": {
+					Description:  "Create an HTTP connector at specified port. Leave it unset to not create the connector",
+					Optional:     true,
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"https_port": {
+					Description:  "Create an HTTPS connector at specified port. Leave it unset to not create the connector",
+					Optional:     true,
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"subdomain": {
+					Description: "Use the repository name as subdomain to reach it, e.g. `docker-group.nexus.example.com`. Requires Nexus >= 3.38",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+				"v1_enabled": {
+					Description: "Whether to allow clients to use the V1 API to interact with this repository",
 					Required:    true,
 					Type:        schema.TypeBool,
 				},
+			},
+		},
+	}
+	DataSourceDocker = &schema.Schema{
+		Description: "docker contains the configuration of the docker repository",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"force_basic_auth": {
+					Description: "Whether to force authentication (Docker Bearer Token Realm required if false)",
+					Computed:    true,
+					Type:        schema.TypeBool,
+				},
 				"http_port": {
 					Description: "Create an HTTP connector at specified port",
-					Optional:    true,
+					Computed:    true,
 					Type:        schema.TypeInt,
 				},
 				"https_port": {
 					Description: "Create an HTTPS connector at specified port",
-					Optional:    true,
+					Computed:    true,
 					Type:        schema.TypeInt,
 				},
+				"subdomain": {
+					Description: "The repository name used as subdomain to reach it",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"v1_enabled": {
+					Description: "Whether to allow clients to use the V1 API to interact with this repository",
+					Computed:    true,
+					Type:        schema.TypeBool,
+				},
+			},
+		},
+	}
+	ResourceDockerWithSubdomain = &schema.Schema{
+		Description: "docker contains the configuration of the docker repository",
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"force_basic_auth": {
+					Default:     true,
+					Description: "Whether to force authentication. Set to false to allow anonymous pulls, which also needs the Docker Bearer Token Realm to be active, see `nexus_security_realms`, and anonymous access to be enabled, see `nexus_security_anonymous`. Default:`true`",
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+				"http_port": {
+					Description:  "Create an HTTP connector at specified port. Leave it unset to not create the connector",
+					Optional:     true,
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"https_port": {
+					Description:  "Create an HTTPS connector at specified port. Leave it unset to not create the connector",
+					Optional:     true,
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"https_certificate_alias": {
+					Description: "Alias of the server certificate the HTTPS connector presents, for Nexus setups with several certificates in their keystore. Requires `https_port`",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+				"subdomain": {
+					Description: "Use the repository name as subdomain to reach it, e.g. `docker-internal.nexus.example.com`. Requires Nexus >= 3.38",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
 				"v1_enabled": {
 					Description: "Whether to allow clients to use the V1 API to interact with this repository",
 					Required:    true,
@@ -35,7 +121,7 @@ var (
 			},
 		},
 	}
-	DataSourceDocker = &schema.Schema{
+	DataSourceDockerWithSubdomain = &schema.Schema{
 		Description: "docker contains the configuration of the docker repository",
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -56,6 +142,16 @@ var (
 					Computed:    true,
 					Type:        schema.TypeInt,
 				},
+				"https_certificate_alias": {
+					Description: "Alias of the server certificate the HTTPS connector presents",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"subdomain": {
+					Description: "The repository name used as subdomain to reach it",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
 				"v1_enabled": {
 					Description: "Whether to allow clients to use the V1 API to interact with this repository",
 					Computed:    true,
@@ -64,4 +160,14 @@ var (
 			},
 		},
 	}
+	ResourceDockerConnectorURL = &schema.Schema{
+		Description: "The address docker clients use to reach the repository, e.g. `nexus.example.com:8085`, derived from the connector and the host of the provider's Nexus URL",
+		Computed:    true,
+		Type:        schema.TypeString,
+	}
+	DataSourceDockerConnectorURL = &schema.Schema{
+		Description: "The address docker clients use to reach the repository, e.g. `nexus.example.com:8085`, derived from the connector and the host of the provider's Nexus URL",
+		Computed:    true,
+		Type:        schema.TypeString,
+	}
 )
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceFormat = &schema.Schema{
+		Computed:    true,
+		Description: "The format of the repository, e.g. `maven2` or `yum`",
+		Type:        schema.TypeString,
+	}
+	ResourceType = &schema.Schema{
+		Computed:    true,
+		Description: "The type of the repository, one of `group`, `hosted` or `proxy`",
+		Type:        schema.TypeString,
+	}
+)
@@ -1,27 +1,33 @@
 package repository
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var (
+	ResourceGroupMemberOrderSensitive = &schema.Schema{
+		Default:     true,
+		Description: "Whether the order of `group.0.member_names` is managed. Set to false to only manage the membership and ignore the order in Nexus. Defaults to `true`",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
 	ResourceGroup = &schema.Schema{
 		Description: "Configuration for repository group",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"member_names": {
-					Description: "Member repositories names",
+					Description:      "Member repositories names. Nexus searches the members in this order",
+					DiffSuppressFunc: suppressGroupMemberOrder,
 					Elem: &schema.Schema{
 						Type: schema.TypeString,
 					},
 					MinItems: 1,
 					Required: true,
-					Set: func(v interface{}) int {
-						return schema.HashString(strings.ToLower(v.(string)))
-					},
-					Type: schema.TypeSet,
+					Type:     schema.TypeList,
 				},
 			},
 		},
@@ -34,16 +40,14 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"member_names": {
-					Description: "Member repositories names",
+					Description:      "Member repositories names. Nexus searches the members in this order",
+					DiffSuppressFunc: suppressGroupMemberOrder,
 					Elem: &schema.Schema{
 						Type: schema.TypeString,
 					},
 					MinItems: 1,
 					Required: true,
-					Set: func(v interface{}) int {
-						return schema.HashString(strings.ToLower(v.(string)))
-					},
-					Type: schema.TypeSet,
+					Type:     schema.TypeList,
 				},
 				"writable_member": {
 					Description: "Pro-only: This field is for the Group Deployment feature available in NXRM Pro.",
@@ -96,3 +100,34 @@ var (
 		Type:     schema.TypeList,
 	}
 )
+
+// suppressGroupMemberOrder suppresses the diff of group.0.member_names when
+// the members only differ in case, or only in order while
+// member_order_sensitive is false
+func suppressGroupMemberOrder(k, old, new string, d *schema.ResourceData) bool {
+	oldValue, newValue := d.GetChange("group.0.member_names")
+	oldNames := lowerGroupMemberNames(oldValue.([]interface{}))
+	newNames := lowerGroupMemberNames(newValue.([]interface{}))
+	if len(oldNames) != len(newNames) {
+		return false
+	}
+
+	if !d.Get("member_order_sensitive").(bool) {
+		sort.Strings(oldNames)
+		sort.Strings(newNames)
+	}
+	for i := range oldNames {
+		if oldNames[i] != newNames[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func lowerGroupMemberNames(names []interface{}) []string {
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		result = append(result, strings.ToLower(fmt.Sprint(name)))
+	}
+	return result
+}
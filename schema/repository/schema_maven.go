@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -15,21 +16,45 @@ var (
 				"version_policy": {
 					Description: "What type of artifacts does this repository store? Possible Value: `RELEASE`, `SNAPSHOT` or `MIXED`",
 					Optional:    true,
+					Computed:    true,
 					Type:        schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"RELEASE",
+						"SNAPSHOT",
+						"MIXED",
+					}, false),
 				},
 				"layout_policy": {
 					Description: "Validate that all paths are maven artifact or metadata paths. Possible Value: `STRICT` or `PERMISSIVE`",
 					Optional:    true,
+					Computed:    true,
 					Type:        schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"STRICT",
+						"PERMISSIVE",
+					}, false),
 				},
 				"content_disposition": {
 					Description: "Add Content-Disposition header as 'Attachment' to disable some content from being inline in a browse. Possible Value: `INLINE` or `ATTACHMENT`",
 					Optional:    true,
+					Computed:    true,
 					Type:        schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"INLINE",
+						"ATTACHMENT",
+					}, false),
 				},
 			},
 		},
 	}
+	ResourceMavenGroup = &schema.Schema{
+		Description: "Maven contains additional data of maven group repository. The `version_policy` is compared to the version policies of the members.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem:        ResourceMaven.Elem,
+	}
 	DataSourceMaven = &schema.Schema{
 		Description: "Maven contains additional data of maven repository",
 		Type:        schema.TypeList,
@@ -6,9 +6,14 @@ import (
 
 var (
 	ResourceName = &schema.Schema{
+		Description: "A unique identifier for this repository",
+		ForceNew:    true,
+		Required:    true,
+		Type:        schema.TypeString,
+	}
+	DataSourceName = &schema.Schema{
 		Description: "A unique identifier for this repository",
 		Required:    true,
 		Type:        schema.TypeString,
 	}
-	DataSourceName = ResourceName
 )
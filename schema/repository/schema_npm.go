@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceNpm = &schema.Schema{
+		Description: "Npm contains additional data of npm proxy repository. Both settings require Nexus Firewall (PRO)",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"remove_non_cataloged": {
+					Default:     false,
+					Description: "Remove non-catalogued versions from the npm package metadata",
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+				"remove_quarantined": {
+					Default:     false,
+					Description: "Remove quarantined versions from the npm package metadata",
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+			},
+		},
+	}
+)
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	DataSourceNuget = &schema.Schema{
+		Description: "Nuget contains additional data of nuget proxy repository",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"nuget_version": {
+					Description: "Nuget protocol version of the remote. Possible values: `V2` or `V3`",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"query_cache_item_max_age": {
+					Description: "How long to cache query results from the proxied repository (in seconds). Only used by the `V2` protocol",
+					Computed:    true,
+					Type:        schema.TypeInt,
+				},
+			},
+		},
+	}
+)
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourcePrimePaths = &schema.Schema{
+		Description: "Paths which are fetched through the repository once after it has been created to prime its cache, e.g. `org/example/app/1.0/app-1.0.jar`. Failed fetches are reported as warnings",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+	ResourcePrimeStrict = &schema.Schema{
+		Description: "Fail the creation if a path of `prime_paths` cannot be fetched instead of reporting a warning. Defaults to `false`",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+)
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,15 +21,16 @@ var (
 					Default:     1440,
 				},
 				"metadata_max_age": {
-					Description: "How long (in minutes) to cache metadata before rechecking the remote repository.",
+					Description: "How long (in minutes) to cache metadata before rechecking the remote repository. A change applies to metadata which is already cached right away, as Nexus compares the age of cached metadata with the current setting on every request.",
 					Type:        schema.TypeInt,
 					Optional:    true,
 					Default:     1440,
 				},
 				"remote_url": {
-					Description: "Location of the remote repository being proxied",
-					Type:        schema.TypeString,
-					Required:    true,
+					Description:      "Location of the remote repository being proxied",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressTrailingSlashDiff,
 				},
 			},
 		},
@@ -57,3 +60,9 @@ var (
 		},
 	}
 )
+
+// suppressTrailingSlashDiff treats URLs which only differ by a trailing slash
+// as equal, as Nexus normalizes some remote URLs by appending one
+func suppressTrailingSlashDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSuffix(old, "/") == strings.TrimSuffix(new, "/")
+}
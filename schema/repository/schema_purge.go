@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourcePurgeOnDestroy = &schema.Schema{
+		Description: "Delete all components of the repository before the repository itself is deleted on destroy, e.g. to reclaim the space in the blobstore. This is irreversible and can be slow for large repositories. Defaults to `false`",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+)
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	ResourcePypiProxy = &schema.Schema{
+		Description: "Configuration for the proxy repository",
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_max_age": {
+					Description: "How long (in minutes) to cache artifacts before rechecking the remote repository",
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     1440,
+				},
+				"metadata_max_age": {
+					Description: "How long (in minutes) to cache metadata before rechecking the remote repository. A change applies to metadata which is already cached right away, as Nexus compares the age of cached metadata with the current setting on every request.",
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     1440,
+				},
+				"remote_url": {
+					Description:      "Location of the PyPI index being proxied, e.g. `https://pypi.org/`. Nexus appends the `simple/` path of the index itself, a URL ending with it, e.g. `https://pypi.org/simple/`, is equivalent and sent without it.",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressPypiIndexURLDiff,
+					ValidateFunc:     validation.IsURLWithHTTPorHTTPS,
+				},
+			},
+		},
+	}
+)
+
+// PypiIndexURL returns the URL of the PyPI index root Nexus stores for a
+// pypi proxy, e.g. https://pypi.org/ for https://pypi.org/simple/
+func PypiIndexURL(remoteURL string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(remoteURL, "/"), "/simple") + "/"
+}
+
+// suppressPypiIndexURLDiff treats URLs which point to the same PyPI index
+// root as equal
+func suppressPypiIndexURLDiff(k, old, new string, d *schema.ResourceData) bool {
+	return PypiIndexURL(old) == PypiIndexURL(new)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	ResourceRaw = &schema.Schema{
+		Description: "Raw contains additional data of raw repository",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_disposition": {
+					Description:  "Add Content-Disposition header as 'Attachment' to disable some content from being inline in a browser. Possible Value: `INLINE` or `ATTACHMENT`",
+					Optional:     true,
+					Computed:     true,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"INLINE", "ATTACHMENT"}, false),
+				},
+			},
+		},
+	}
+	DataSourceRaw = &schema.Schema{
+		Description: "Raw contains additional data of raw repository",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_disposition": {
+					Description: "Add Content-Disposition header as 'Attachment' to disable some content from being inline in a browser",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+			},
+		},
+	}
+)
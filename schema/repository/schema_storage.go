@@ -68,12 +68,13 @@ var (
 					Type: schema.TypeString,
 				},
 				"strict_content_type_validation": {
+					Default:     true,
 					Description: "Whether to validate uploaded content's MIME type appropriate for the repository format",
-					Required:    true,
+					Optional:    true,
 					Type:        schema.TypeBool,
 				},
 				"write_policy": {
-					Description: "Controls if deployments of and updates to assets are allowed",
+					Description: "Controls if deployments of and updates to assets are allowed. `DENY` makes the repository read-only while it stays online, e.g. to freeze it for maintenance",
 					Default:     "ALLOW",
 					Optional:    true,
 					Type:        schema.TypeString,
@@ -110,4 +111,74 @@ var (
 			},
 		},
 	}
+	ResourceDockerHostedStorage = &schema.Schema{
+		Description: "The storage configuration of the repository",
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"blob_store_name": {
+					Description: "Blob store used to store repository contents",
+					Required:    true,
+					Set: func(v interface{}) int {
+						return schema.HashString(strings.ToLower(v.(string)))
+					},
+					Type: schema.TypeString,
+				},
+				"strict_content_type_validation": {
+					Default:     true,
+					Description: "Whether to validate uploaded content's MIME type appropriate for the repository format",
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+				"write_policy": {
+					Description: "Controls if deployments of and updates to assets are allowed. `DENY` makes the repository read-only while it stays online, e.g. to freeze it for maintenance",
+					Default:     "ALLOW",
+					Optional:    true,
+					Type:        schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"ALLOW",
+						"ALLOW_ONCE",
+						"DENY",
+					}, false),
+				},
+				"latest_policy": {
+					Description: "Whether to allow redeploying the `latest` tag but defer to the write policy for all other tags. Only applies to write_policy `ALLOW_ONCE`",
+					Default:     false,
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+			},
+		},
+	}
+	DataSourceDockerHostedStorage = &schema.Schema{
+		Description: "The storage configuration of the repository",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"blob_store_name": {
+					Description: "Blob store used to store repository contents",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"strict_content_type_validation": {
+					Description: "Whether to validate uploaded content's MIME type appropriate for the repository format",
+					Computed:    true,
+					Type:        schema.TypeBool,
+				},
+				"write_policy": {
+					Description: "Controls if deployments of and updates to assets are allowed",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"latest_policy": {
+					Description: "Whether to allow redeploying the `latest` tag but defer to the write policy for all other tags",
+					Computed:    true,
+					Type:        schema.TypeBool,
+				},
+			},
+		},
+	}
 )
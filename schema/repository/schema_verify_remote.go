@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceVerifyRemoteOnCreate = &schema.Schema{
+		Description: "Verify once after the repository has been created that Nexus can reach the remote through it. An unreachable remote fails the creation, the repository is then tainted. Defaults to `false`",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+)
@@ -0,0 +1,75 @@
+package blobstore
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/blobstore"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceBlobstore() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the type and usage of an existing Nexus blobstore, regardless of its backing store.",
+
+		Read: dataSourceBlobstoreRead,
+		Schema: map[string]*schema.Schema{
+			"id":   common.DataSourceID,
+			"name": blobstore.DataSourceName,
+			"type": {
+				Description: "The type of the blobstore, e.g. File, S3, Azure Cloud Storage or Group",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"unavailable": {
+				Description: "Whether the blobstore is currently unavailable",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"available_space_in_bytes": blobstore.DataSourceAvailableSpaceInBytes,
+			"blob_count":               blobstore.DataSourceBlobCount,
+			"soft_quota":               blobstore.DataSourceSoftQuota,
+			"total_size_in_bytes":      blobstore.DataSourceTotalSizeInBytes,
+		},
+	}
+}
+
+func dataSourceBlobstoreRead(resourceData *schema.ResourceData, m interface{}) error {
+	nexusClient := m.(*api.Client)
+	name := resourceData.Get("name").(string)
+
+	genericBlobstores, err := nexusClient.BlobStore.List()
+	if err != nil {
+		return fmt.Errorf("reading blobstore %q: %w", name, err)
+	}
+
+	for _, generic := range genericBlobstores {
+		if generic.Name != name {
+			continue
+		}
+
+		resourceData.SetId(generic.Name)
+		if err := resourceData.Set("type", generic.Type); err != nil {
+			return err
+		}
+		if err := resourceData.Set("unavailable", generic.Unavailable); err != nil {
+			return err
+		}
+		if err := resourceData.Set("available_space_in_bytes", generic.AvailableSpaceInBytes); err != nil {
+			return err
+		}
+		if err := resourceData.Set("blob_count", generic.BlobCount); err != nil {
+			return err
+		}
+		if err := resourceData.Set("total_size_in_bytes", generic.TotalSizeInBytes); err != nil {
+			return err
+		}
+		if err := resourceData.Set("soft_quota", flattenSoftQuota(generic.SoftQuota)); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	return fmt.Errorf("reading blobstore %q: blobstore not found", name)
+}
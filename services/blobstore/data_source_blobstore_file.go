@@ -22,6 +22,7 @@ func DataSourceBlobstoreFile() *schema.Resource {
 			"available_space_in_bytes": blobstore.DataSourceAvailableSpaceInBytes,
 			"blob_count":               blobstore.DataSourceBlobCount,
 			"soft_quota":               blobstore.DataSourceSoftQuota,
+			"soft_quota_status":        blobstore.DataSourceSoftQuotaStatus,
 			"total_size_in_bytes":      blobstore.DataSourceTotalSizeInBytes,
 		},
 	}
@@ -0,0 +1,82 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceBlobstore(t *testing.T) {
+	dataSourceName := "data.nexus_blobstore.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceBlobstoreConfig("default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "File"),
+					resource.TestCheckResourceAttr(dataSourceName, "unavailable", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "blob_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_size_in_bytes"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "available_space_in_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceBlobstoreConfig(name string) string {
+	return fmt.Sprintf(`
+data "nexus_blobstore" "acceptance" {
+	name = "%s"
+}`, name)
+}
+
+func TestDataSourceBlobstoreRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores" {
+			fmt.Fprint(w, `[
+				{"name":"default","type":"File","availableSpaceInBytes":1000,"blobCount":1,"totalSizeInBytes":10},
+				{"name":"artifacts","type":"S3","availableSpaceInBytes":2000,"blobCount":42,"totalSizeInBytes":4096,"softQuota":{"type":"spaceUsedQuota","limit":1000000}}
+			]`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_blobstore"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "artifacts"})
+	err := res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "artifacts", resourceData.Id())
+	assert.Equal(t, "S3", resourceData.Get("type"))
+	assert.Equal(t, false, resourceData.Get("unavailable"))
+	assert.Equal(t, 2000, resourceData.Get("available_space_in_bytes"))
+	assert.Equal(t, 42, resourceData.Get("blob_count"))
+	assert.Equal(t, 4096, resourceData.Get("total_size_in_bytes"))
+	assert.Equal(t, "spaceUsedQuota", resourceData.Get("soft_quota.0.type"))
+	assert.Equal(t, 1000000, resourceData.Get("soft_quota.0.limit"))
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "missing"})
+	err = res.Read(resourceData, nexusClient)
+	assert.EqualError(t, err, `reading blobstore "missing": blobstore not found`)
+}
@@ -101,3 +101,15 @@ func flattenEncryption(encryption *blobstore.S3Encryption) []map[string]interfac
 		},
 	}
 }
+
+func flattenSoftQuotaStatus(quotaStatus *blobstore.QuotaStatus) []map[string]interface{} {
+	if quotaStatus == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"is_violation": quotaStatus.IsViolation,
+			"message":      quotaStatus.Message,
+		},
+	}
+}
@@ -2,11 +2,10 @@ package blobstore
 
 import (
 	"fmt"
-	"log"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	blobstoreSchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/blobstore"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,11 +17,11 @@ func ResourceBlobstoreAzure() *schema.Resource {
 
 Use this resource to create a Nexus Azure blobstore.`,
 
-		Create: resourceBlobstoreAzureCreate,
-		Read:   resourceBlobstoreAzureRead,
-		Update: resourceBlobstoreAzureUpdate,
-		Delete: resourceBlobstoreAzureDelete,
-		Exists: resourceBlobstoreAzureExists,
+		CreateContext: withSoftQuotaWarnings(resourceBlobstoreAzureCreate),
+		Read:          resourceBlobstoreAzureRead,
+		UpdateContext: withSoftQuotaWarnings(resourceBlobstoreAzureUpdate),
+		Delete:        resourceBlobstoreAzureDelete,
+		Exists:        resourceBlobstoreAzureExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -111,12 +110,12 @@ func getBlobstoreAzureFromResourceData(d *schema.ResourceData) blobstore.Azure {
 }
 
 func resourceBlobstoreAzureCreate(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs := getBlobstoreAzureFromResourceData(resourceData)
 
 	if err := nexusClient.BlobStore.Azure.Create(&bs); err != nil {
-		return err
+		return fmt.Errorf("creating azure blobstore %q: %w", bs.Name, err)
 	}
 
 	resourceData.SetId(bs.Name)
@@ -126,18 +125,17 @@ func resourceBlobstoreAzureCreate(resourceData *schema.ResourceData, m interface
 }
 
 func resourceBlobstoreAzureRead(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs, err := nexusClient.BlobStore.Azure.Get(resourceData.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return fmt.Errorf("reading azure blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	var genericBlobstoreInformation blobstore.Generic
 	genericBlobstores, err := nexusClient.BlobStore.List()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading azure blobstore %q: %w", resourceData.Id(), err)
 	}
 	for _, generic := range genericBlobstores {
 		if generic.Name == bs.Name {
@@ -173,21 +171,21 @@ func resourceBlobstoreAzureRead(resourceData *schema.ResourceData, m interface{}
 }
 
 func resourceBlobstoreAzureUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs := getBlobstoreAzureFromResourceData(resourceData)
 	if err := nexusClient.BlobStore.Azure.Update(resourceData.Id(), &bs); err != nil {
-		return err
+		return fmt.Errorf("updating azure blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	return nil
 }
 
 func resourceBlobstoreAzureDelete(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	if err := nexusClient.BlobStore.Azure.Delete(resourceData.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting azure blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	resourceData.SetId("")
@@ -196,7 +194,7 @@ func resourceBlobstoreAzureDelete(resourceData *schema.ResourceData, m interface
 }
 
 func resourceBlobstoreAzureExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs, err := nexusClient.BlobStore.Azure.Get(resourceData.Id())
 	return bs != nil, err
@@ -2,44 +2,115 @@ package blobstore
 
 import (
 	"fmt"
-	"log"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	blobstoreSchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/blobstore"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceBlobstoreFile() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus file blobstore.",
 
-		Create: resourceBlobstoreFileCreate,
-		Read:   resourceBlobstoreFileRead,
-		Update: resourceBlobstoreFileUpdate,
-		Delete: resourceBlobstoreFileDelete,
-		Exists: resourceBlobstoreFileExists,
+		CreateContext: withSoftQuotaWarnings(resourceBlobstoreFileCreate),
+		Read:          resourceBlobstoreFileRead,
+		UpdateContext: withSoftQuotaWarnings(resourceBlobstoreFileUpdate),
+		Delete:        resourceBlobstoreFileDelete,
+		Exists:        resourceBlobstoreFileExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceBlobstoreFileV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: common.IdentityStateUpgrade,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"id":   common.ResourceID,
 			"name": blobstoreSchema.ResourceName,
 			"path": {
-				Description: "The path to the blobstore contents. This can be an absolute path to anywhere on the system nxrm has access to or it can be a path relative to the sonatype-work directory",
+				Description: "The path to the blobstore contents. This can be an absolute path to anywhere on the system nxrm has access to or it can be a path relative to the sonatype-work directory. Nexus cannot move a blobstore, so changing the path replaces the blobstore. Defaults to the name of the blobstore",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:  "The type of the blobstore. Always `File` for this resource",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{blobstore.BlobstoreTypeFile}, false),
 			},
 			"available_space_in_bytes": blobstoreSchema.ResourceAvailableSpaceInBytes,
 			"blob_count":               blobstoreSchema.ResourceBlobCount,
 			"soft_quota":               blobstoreSchema.ResourceSoftQuota,
+			"soft_quota_status":        blobstoreSchema.ResourceSoftQuotaStatus,
 			"total_size_in_bytes":      blobstoreSchema.ResourceTotalSizeInBytes,
 		},
 	}
 }
 
+// resourceBlobstoreFileV0 is the resource of schema version 0 as released
+// before type and soft_quota_status were added. Both are computed, so states
+// of version 0 are valid for version 1 as they are. It must not change
+// anymore, as it describes the states written by older providers.
+func resourceBlobstoreFileV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Computed: true,
+				Type:     schema.TypeString,
+			},
+			"name": {
+				Required: true,
+				Type:     schema.TypeString,
+			},
+			"path": {
+				Optional: true,
+				Type:     schema.TypeString,
+			},
+			"available_space_in_bytes": {
+				Computed: true,
+				Type:     schema.TypeInt,
+			},
+			"blob_count": {
+				Computed: true,
+				Type:     schema.TypeInt,
+			},
+			"soft_quota": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limit": {
+							Required: true,
+							Type:     schema.TypeInt,
+						},
+						"type": {
+							Required: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
+			"total_size_in_bytes": {
+				Computed: true,
+				Type:     schema.TypeInt,
+			},
+		},
+	}
+}
+
 func getBlobstoreFileFromResourceData(resourceData *schema.ResourceData) blobstore.File {
 	bs := blobstore.File{
 		Name: resourceData.Get("name").(string),
@@ -62,12 +133,12 @@ func getBlobstoreFileFromResourceData(resourceData *schema.ResourceData) blobsto
 }
 
 func resourceBlobstoreFileCreate(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs := getBlobstoreFileFromResourceData(resourceData)
 
 	if err := nexusClient.BlobStore.File.Create(&bs); err != nil {
-		return err
+		return fmt.Errorf("creating file blobstore %q: %w", bs.Name, err)
 	}
 
 	resourceData.SetId(bs.Name)
@@ -80,18 +151,17 @@ func resourceBlobstoreFileCreate(resourceData *schema.ResourceData, m interface{
 }
 
 func resourceBlobstoreFileRead(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs, err := nexusClient.BlobStore.File.Get(resourceData.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return fmt.Errorf("reading file blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	var genericBlobstoreInformation blobstore.Generic
 	genericBlobstores, err := nexusClient.BlobStore.List()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading file blobstore %q: %w", resourceData.Id(), err)
 	}
 	for _, generic := range genericBlobstores {
 		if generic.Name == bs.Name {
@@ -119,6 +189,9 @@ func resourceBlobstoreFileRead(resourceData *schema.ResourceData, m interface{})
 	if err := resourceData.Set("total_size_in_bytes", genericBlobstoreInformation.TotalSizeInBytes); err != nil {
 		return err
 	}
+	if err := resourceData.Set("type", blobstore.BlobstoreTypeFile); err != nil {
+		return err
+	}
 
 	if bs.SoftQuota != nil {
 		if err := resourceData.Set("soft_quota", flattenSoftQuota(bs.SoftQuota)); err != nil {
@@ -126,25 +199,33 @@ func resourceBlobstoreFileRead(resourceData *schema.ResourceData, m interface{})
 		}
 	}
 
+	quotaStatus, err := api.NewBlobstoreQuotaService(nexusClient).GetStatus(bs.Name)
+	if err != nil {
+		return fmt.Errorf("reading file blobstore %q: %w", resourceData.Id(), err)
+	}
+	if err := resourceData.Set("soft_quota_status", flattenSoftQuotaStatus(quotaStatus)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceBlobstoreFileUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs := getBlobstoreFileFromResourceData(resourceData)
 	if err := nexusClient.BlobStore.File.Update(resourceData.Id(), &bs); err != nil {
-		return err
+		return fmt.Errorf("updating file blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	return nil
 }
 
 func resourceBlobstoreFileDelete(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	if err := nexusClient.BlobStore.File.Delete(resourceData.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting file blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	resourceData.SetId("")
@@ -153,7 +234,7 @@ func resourceBlobstoreFileDelete(resourceData *schema.ResourceData, m interface{
 }
 
 func resourceBlobstoreFileExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs, err := nexusClient.BlobStore.File.Get(resourceData.Id())
 	return bs != nil, err
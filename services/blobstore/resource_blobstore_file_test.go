@@ -2,15 +2,26 @@ package blobstore_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -58,11 +69,17 @@ func TestAccResourceBlobstoreFile(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "id", bs.Name),
 					resource.TestCheckResourceAttr(resourceName, "name", bs.Name),
 					resource.TestCheckResourceAttr(resourceName, "path", bs.Path),
+					resource.TestCheckResourceAttr(resourceName, "type", blobstore.BlobstoreTypeFile),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "soft_quota.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "soft_quota.0.limit", strconv.FormatInt(bs.SoftQuota.Limit, 10)),
 						resource.TestCheckResourceAttr(resourceName, "soft_quota.0.type", bs.SoftQuota.Type),
 					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "soft_quota_status.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "soft_quota_status.0.is_violation", "false"),
+						resource.TestCheckResourceAttrSet(resourceName, "soft_quota_status.0.message"),
+					),
 					resource.TestCheckResourceAttrSet(resourceName, "blob_count"),
 					resource.TestCheckResourceAttrSet(resourceName, "total_size_in_bytes"),
 					resource.TestCheckResourceAttrSet(resourceName, "available_space_in_bytes"),
@@ -78,3 +95,326 @@ func TestAccResourceBlobstoreFile(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceBlobstoreFileErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, "internal error")
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "blobstore-file",
+		"path": "/nexus-data/blobstore-file",
+	})
+
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	if assert.Len(t, diags, 1) {
+		assert.Regexp(t, `^creating file blobstore "blobstore-file": .*internal error$`, diags[0].Summary)
+	}
+
+	resourceData.SetId("blobstore-file")
+	err := res.Read(resourceData, nexusClient)
+	assert.Regexp(t, `^reading file blobstore "blobstore-file": .*internal error$`, err)
+
+	diags = res.UpdateContext(context.Background(), resourceData, nexusClient)
+	if assert.Len(t, diags, 1) {
+		assert.Regexp(t, `^updating file blobstore "blobstore-file": .*internal error$`, diags[0].Summary)
+	}
+
+	err = res.Delete(resourceData, nexusClient)
+	assert.Regexp(t, `^deleting file blobstore "blobstore-file": .*internal error$`, err)
+}
+
+func TestResourceBlobstoreFileType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/blobstores/file":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores/file/blobstore-file":
+			fmt.Fprint(w, `{"path":"/nexus-data/blobstore-file"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores":
+			fmt.Fprint(w, `[{"name":"blobstore-file","type":"File"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "blobstore-file",
+		"path": "/nexus-data/blobstore-file",
+	})
+
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, blobstore.BlobstoreTypeFile, resourceData.Get("type"))
+
+	_, errs := res.Schema["type"].ValidateFunc("S3", "type")
+	assert.Len(t, errs, 1)
+}
+
+func TestResourceBlobstoreFileNameForceNew(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	state := &terraform.InstanceState{
+		ID: "blobstore-file",
+		Attributes: map[string]string{
+			"id":   "blobstore-file",
+			"name": "blobstore-file",
+			"path": "/nexus-data/blobstore-file",
+			"type": blobstore.BlobstoreTypeFile,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "blobstore-file-renamed",
+		"path": "/nexus-data/blobstore-file",
+	})
+
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["name"].RequiresNew)
+}
+
+func TestResourceBlobstoreFilePathForceNew(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	state := &terraform.InstanceState{
+		ID: "blobstore-file",
+		Attributes: map[string]string{
+			"id":   "blobstore-file",
+			"name": "blobstore-file",
+			"path": "/nexus-data/blobstore-file",
+			"type": blobstore.BlobstoreTypeFile,
+		},
+	}
+
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "blobstore-file",
+		"path": "/mnt/blobs/blobstore-file",
+	}), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["path"].RequiresNew)
+
+	// Without a configured path Nexus keeps the one it chose
+	diff, err = res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "blobstore-file",
+	}), nil)
+	assert.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+}
+
+func TestResourceBlobstoreFileSoftQuotaStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/blobstores/file":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores/file/blobstore-file":
+			fmt.Fprint(w, `{"path":"/nexus-data/blobstore-file","softQuota":{"type":"spaceUsedQuota","limit":1000000}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores":
+			fmt.Fprint(w, `[{"name":"blobstore-file","type":"File","totalSizeInBytes":2000000}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores/blobstore-file/quota-status":
+			fmt.Fprint(w, `{"isViolation":true,"message":"Blob store blobstore-file is violating its quota","blobStoreName":"blobstore-file"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "blobstore-file",
+		"path": "/nexus-data/blobstore-file",
+		"soft_quota": []interface{}{map[string]interface{}{
+			"limit": 1000000,
+			"type":  "spaceUsedQuota",
+		}},
+	})
+
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 1, resourceData.Get("soft_quota_status.#"))
+	assert.Equal(t, true, resourceData.Get("soft_quota_status.0.is_violation"))
+	assert.Equal(t, "Blob store blobstore-file is violating its quota", resourceData.Get("soft_quota_status.0.message"))
+}
+
+func TestResourceBlobstoreFileReadDoesNotLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores/file/blobstore-file":
+			fmt.Fprint(w, `{"path":"/nexus-data/blobstore-file"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores":
+			fmt.Fprint(w, `[{"name":"blobstore-file","type":"File","totalSizeInBytes":2000000}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "blobstore-file"})
+	resourceData.SetId("blobstore-file")
+
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, "/nexus-data/blobstore-file", resourceData.Get("path"))
+	assert.Empty(t, output.String())
+}
+
+func TestResourceBlobstoreFileStateUpgradeV0(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	assert.Equal(t, 1, res.SchemaVersion)
+	if !assert.Len(t, res.StateUpgraders, 1) {
+		return
+	}
+	upgrader := res.StateUpgraders[0]
+	assert.Equal(t, 0, upgrader.Version)
+	// Version 0 states lack the computed attributes added since
+	assert.False(t, upgrader.Type.HasAttribute("type"))
+	assert.False(t, upgrader.Type.HasAttribute("soft_quota_status"))
+
+	rawState := map[string]interface{}{
+		"id":   "blobstore-file",
+		"name": "blobstore-file",
+		"path": "/nexus-data/blobstore-file",
+		"soft_quota": []interface{}{map[string]interface{}{
+			"limit": float64(1024000000),
+			"type":  "spaceRemainingQuota",
+		}},
+		"available_space_in_bytes": float64(2048000000),
+		"blob_count":               float64(3),
+		"total_size_in_bytes":      float64(4096),
+	}
+
+	upgraded, err := upgrader.Upgrade(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, rawState, upgraded)
+
+	value, err := schema.JSONMapToStateValue(upgraded, res.CoreConfigSchema())
+	if assert.NoError(t, err) {
+		assert.Equal(t, "/nexus-data/blobstore-file", value.GetAttr("path").AsString())
+	}
+}
+
+func TestResourceBlobstoreFileSoftQuotaWarning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/blobstores/file":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPut && r.URL.Path == "/service/rest/v1/blobstores/file/blobstore-file":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores/file/blobstore-file":
+			fmt.Fprint(w, `{"path":"/nexus-data/blobstore-file"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/blobstores":
+			fmt.Fprint(w, `[{"name":"blobstore-file","type":"File"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	create := func(quotaType string, limit int) diag.Diagnostics {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name": "blobstore-file",
+			"soft_quota": []interface{}{map[string]interface{}{
+				"limit": limit,
+				"type":  quotaType,
+			}},
+		})
+		return res.CreateContext(context.Background(), resourceData, nexusClient)
+	}
+
+	// 10 MB of remaining space was most likely meant as used space
+	diags := create("spaceRemainingQuota", 10000000)
+	assert.False(t, diags.HasError(), "%v", diags)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, `soft quota of blobstore "blobstore-file" is only violated when the blobstore is almost full`, diags[0].Summary)
+	}
+
+	// 500 was most likely meant as megabytes
+	diags = create("spaceUsedQuota", 500000)
+	assert.False(t, diags.HasError(), "%v", diags)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, `soft quota of blobstore "blobstore-file" is violated once the blobstore holds more than 500000 bytes`, diags[0].Summary)
+	}
+
+	assert.Empty(t, create("spaceRemainingQuota", 50*1024*1024*1024))
+	assert.Empty(t, create("spaceUsedQuota", 500*1024*1024*1024))
+
+	// Updates are checked as well
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "blobstore-file",
+		"soft_quota": []interface{}{map[string]interface{}{
+			"limit": 500000,
+			"type":  "spaceUsedQuota",
+		}},
+	})
+	resourceData.SetId("blobstore-file")
+	diags = res.UpdateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+	}
+}
+
+func TestResourceBlobstoreFileSoftQuotaWarningOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `[{"id":"name","message":"Blob store blobstore-file already exists"}]`)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_file"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "blobstore-file",
+		"soft_quota": []interface{}{map[string]interface{}{
+			"limit": 500000,
+			"type":  "spaceUsedQuota",
+		}},
+	})
+
+	// A failed create only reports its error
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Error, diags[0].Severity)
+		assert.Contains(t, diags[0].Summary, "already exists")
+	}
+}
@@ -2,12 +2,11 @@ package blobstore
 
 import (
 	"fmt"
-	"log"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	blobstoreSchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/blobstore"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,11 +18,11 @@ func ResourceBlobstoreGroup() *schema.Resource {
 
 Use this resource to create a Nexus group blobstore.`,
 
-		Create: resourceBlobstoreGroupCreate,
-		Read:   resourceBlobstoreGroupRead,
-		Update: resourceBlobstoreGroupUpdate,
-		Delete: resourceBlobstoreGroupDelete,
-		Exists: resourceBlobstoreGroupExists,
+		CreateContext: withSoftQuotaWarnings(resourceBlobstoreGroupCreate),
+		Read:          resourceBlobstoreGroupRead,
+		UpdateContext: withSoftQuotaWarnings(resourceBlobstoreGroupUpdate),
+		Delete:        resourceBlobstoreGroupDelete,
+		Exists:        resourceBlobstoreGroupExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -74,12 +73,12 @@ func getBlobstoreGroupFromResourceData(resourceData *schema.ResourceData) blobst
 }
 
 func resourceBlobstoreGroupCreate(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs := getBlobstoreGroupFromResourceData(resourceData)
 
 	if err := nexusClient.BlobStore.Group.Create(&bs); err != nil {
-		return err
+		return fmt.Errorf("creating group blobstore %q: %w", bs.Name, err)
 	}
 
 	resourceData.SetId(bs.Name)
@@ -92,18 +91,17 @@ func resourceBlobstoreGroupCreate(resourceData *schema.ResourceData, m interface
 }
 
 func resourceBlobstoreGroupRead(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs, err := nexusClient.BlobStore.Group.Get(resourceData.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return fmt.Errorf("reading group blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	var genericBlobstoreInformation blobstore.Generic
 	genericBlobstores, err := nexusClient.BlobStore.List()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading group blobstore %q: %w", resourceData.Id(), err)
 	}
 	for _, generic := range genericBlobstores {
 		if generic.Name == bs.Name {
@@ -145,21 +143,21 @@ func resourceBlobstoreGroupRead(resourceData *schema.ResourceData, m interface{}
 }
 
 func resourceBlobstoreGroupUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs := getBlobstoreGroupFromResourceData(resourceData)
 	if err := nexusClient.BlobStore.Group.Update(resourceData.Id(), &bs); err != nil {
-		return err
+		return fmt.Errorf("updating group blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	return nil
 }
 
 func resourceBlobstoreGroupDelete(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	if err := nexusClient.BlobStore.Group.Delete(resourceData.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting group blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	resourceData.SetId("")
@@ -168,7 +166,7 @@ func resourceBlobstoreGroupDelete(resourceData *schema.ResourceData, m interface
 }
 
 func resourceBlobstoreGroupExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs, err := nexusClient.BlobStore.Group.Get(resourceData.Id())
 	return bs != nil, err
@@ -2,11 +2,10 @@ package blobstore
 
 import (
 	"fmt"
-	"log"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	blobstoreSchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/blobstore"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,13 +14,13 @@ import (
 
 func ResourceBlobstoreS3() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create a Nexus S3 blobstore.",
+		Description: "Use this resource to create a Nexus S3 blobstore. Nexus creates the S3 bucket if it does not exist yet and otherwise uses the existing bucket. Destroying this resource only removes the blobstore from Nexus, the provider never deletes the S3 bucket itself.",
 
-		Create: resourceBlobstoreS3Create,
-		Read:   resourceBlobstoreS3Read,
-		Update: resourceBlobstoreS3Update,
-		Delete: resourceBlobstoreS3Delete,
-		Exists: resourceBlobstoreS3Exists,
+		CreateContext: withSoftQuotaWarnings(resourceBlobstoreS3Create),
+		Read:          resourceBlobstoreS3Read,
+		UpdateContext: withSoftQuotaWarnings(resourceBlobstoreS3Update),
+		Delete:        resourceBlobstoreS3Delete,
+		Exists:        resourceBlobstoreS3Exists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -47,7 +46,7 @@ func ResourceBlobstoreS3() *schema.Resource {
 									},
 									"force_path_style": {
 										Default:     false,
-										Description: "Setting this flag will result in path-style access being used for all requests.",
+										Description: "Setting this flag will result in path-style access (`https://endpoint/bucket/key`) being used for all requests instead of virtual-hosted-style access. Most third party object stores require this.",
 										Optional:    true,
 										Type:        schema.TypeBool,
 									},
@@ -72,12 +71,13 @@ func ResourceBlobstoreS3() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"region": {
-										Description: "The AWS region to create a new S3 bucket in or an existing S3 bucket's region",
-										Required:    true,
-										Type:        schema.TypeString,
+										Description:  "The AWS region to create a new S3 bucket in or an existing S3 bucket's region. Use `DEFAULT` to let Nexus choose the region. Regions which are not known AWS regions only produce a warning, as third party object stores may use their own",
+										Required:     true,
+										Type:         schema.TypeString,
+										ValidateFunc: validateS3Region,
 									},
 									"name": {
-										Description: "The name of the S3 bucket",
+										Description: "The name of the S3 bucket. Nexus creates the bucket if it does not exist, otherwise the existing bucket is used",
 										Required:    true,
 										Type:        schema.TypeString,
 									},
@@ -87,9 +87,10 @@ func ResourceBlobstoreS3() *schema.Resource {
 										Type:        schema.TypeString,
 									},
 									"expiration": {
-										Description: "How many days until deleted blobs are finally removed from the S3 bucket (-1 to disable)",
-										Required:    true,
-										Type:        schema.TypeInt,
+										Description:  "How many days until deleted blobs are finally removed from the S3 bucket (-1 to disable). Nexus applies this as a lifecycle rule on the bucket",
+										Required:     true,
+										Type:         schema.TypeInt,
+										ValidateFunc: validation.IntAtLeast(-1),
 									},
 								},
 							},
@@ -231,12 +232,12 @@ func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
 }
 
 func resourceBlobstoreS3Create(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs := getBlobstoreS3FromResourceData(resourceData)
 
 	if err := nexusClient.BlobStore.S3.Create(&bs); err != nil {
-		return err
+		return fmt.Errorf("creating s3 blobstore %q: %w", bs.Name, err)
 	}
 
 	resourceData.SetId(bs.Name)
@@ -246,18 +247,17 @@ func resourceBlobstoreS3Create(resourceData *schema.ResourceData, m interface{})
 }
 
 func resourceBlobstoreS3Read(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs, err := nexusClient.BlobStore.S3.Get(resourceData.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return fmt.Errorf("reading s3 blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	var genericBlobstoreInformation blobstore.Generic
 	genericBlobstores, err := nexusClient.BlobStore.List()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading s3 blobstore %q: %w", resourceData.Id(), err)
 	}
 	for _, generic := range genericBlobstores {
 		if generic.Name == bs.Name {
@@ -293,21 +293,21 @@ func resourceBlobstoreS3Read(resourceData *schema.ResourceData, m interface{}) e
 }
 
 func resourceBlobstoreS3Update(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs := getBlobstoreS3FromResourceData(resourceData)
 	if err := nexusClient.BlobStore.S3.Update(resourceData.Id(), &bs); err != nil {
-		return err
+		return fmt.Errorf("updating s3 blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	return nil
 }
 
 func resourceBlobstoreS3Delete(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	if err := nexusClient.BlobStore.S3.Delete(resourceData.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting s3 blobstore %q: %w", resourceData.Id(), err)
 	}
 
 	resourceData.SetId("")
@@ -316,7 +316,7 @@ func resourceBlobstoreS3Delete(resourceData *schema.ResourceData, m interface{})
 }
 
 func resourceBlobstoreS3Exists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	nexusClient := m.(*nexus.NexusClient)
+	nexusClient := m.(*api.Client)
 
 	bs, err := nexusClient.BlobStore.S3.Get(resourceData.Id())
 	return bs != nil, err
@@ -2,14 +2,21 @@ package blobstore_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceBlobstoreS3(t *testing.T) {
@@ -91,3 +98,65 @@ resource "nexus_blobstore_s3" "acceptance" {
 	}
 }`, bs.Name, bs.BucketConfiguration.Bucket.Name, bs.BucketConfiguration.Bucket.Region, bs.BucketConfiguration.Bucket.Expiration, awsAccessKeyID, awsSecretAccessKey, bs.BucketConfiguration.AdvancedBucketConnection.Endpoint, strconv.FormatBool(*bs.BucketConfiguration.AdvancedBucketConnection.ForcePathStyle))
 }
+
+func TestResourceBlobstoreS3DeleteKeepsBucket(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_s3"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "blobstore-s3",
+		"bucket_configuration": []interface{}{map[string]interface{}{
+			"bucket": []interface{}{map[string]interface{}{
+				"name":       "nexus-artifacts",
+				"region":     "eu-central-1",
+				"expiration": 3,
+			}},
+		}},
+	})
+	resourceData.SetId("blobstore-s3")
+
+	err := res.Delete(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"DELETE /service/rest/v1/blobstores/blobstore-s3"}, requests)
+	assert.Empty(t, resourceData.Id())
+}
+
+func TestResourceBlobstoreS3RegionValidation(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_blobstore_s3"]
+	config := func(region string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "blobstore-s3",
+			"bucket_configuration": []interface{}{map[string]interface{}{
+				"bucket": []interface{}{map[string]interface{}{
+					"name":       "nexus-artifacts",
+					"region":     region,
+					"expiration": 3,
+				}},
+			}},
+		})
+	}
+
+	for _, region := range []string{"eu-central-1", "us-gov-west-1", "DEFAULT"} {
+		diags := res.Validate(config(region))
+		assert.Empty(t, diags, region)
+	}
+
+	diags := res.Validate(config("minio-local"))
+	assert.False(t, diags.HasError())
+	if assert.Len(t, diags, 1) {
+		assert.Contains(t, diags[0].Summary, `"minio-local" is not a known AWS region`)
+	}
+
+	diags = res.Validate(config(""))
+	assert.True(t, diags.HasError())
+}
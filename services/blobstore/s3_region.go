@@ -0,0 +1,60 @@
+package blobstore
+
+import "fmt"
+
+// s3DefaultRegion lets Nexus pick the region from its own AWS configuration.
+const s3DefaultRegion = "DEFAULT"
+
+var knownS3Regions = map[string]bool{
+	s3DefaultRegion:  true,
+	"af-south-1":     true,
+	"ap-east-1":      true,
+	"ap-northeast-1": true,
+	"ap-northeast-2": true,
+	"ap-northeast-3": true,
+	"ap-south-1":     true,
+	"ap-south-2":     true,
+	"ap-southeast-1": true,
+	"ap-southeast-2": true,
+	"ap-southeast-3": true,
+	"ap-southeast-4": true,
+	"ca-central-1":   true,
+	"cn-north-1":     true,
+	"cn-northwest-1": true,
+	"eu-central-1":   true,
+	"eu-central-2":   true,
+	"eu-north-1":     true,
+	"eu-south-1":     true,
+	"eu-south-2":     true,
+	"eu-west-1":      true,
+	"eu-west-2":      true,
+	"eu-west-3":      true,
+	"il-central-1":   true,
+	"me-central-1":   true,
+	"me-south-1":     true,
+	"sa-east-1":      true,
+	"us-east-1":      true,
+	"us-east-2":      true,
+	"us-gov-east-1":  true,
+	"us-gov-west-1":  true,
+	"us-west-1":      true,
+	"us-west-2":      true,
+}
+
+// validateS3Region warns about regions which are not known AWS regions.
+// Third party object stores using the S3 API may use regions of their own,
+// so an unknown region never fails validation.
+func validateS3Region(v interface{}, k string) (warnings []string, errors []error) {
+	region, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if region == "" {
+		return nil, []error{fmt.Errorf("%s must not be empty, use %q to let Nexus choose the region", k, s3DefaultRegion)}
+	}
+	if !knownS3Regions[region] {
+		warnings = append(warnings, fmt.Sprintf("%s %q is not a known AWS region, ignore this if the bucket lives in a third party object store configured with an endpoint", k, region))
+	}
+
+	return warnings, nil
+}
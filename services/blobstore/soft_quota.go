@@ -0,0 +1,55 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// softQuotaSmallLimit is the limit below which a soft quota most likely does
+// not do what it was meant to, e.g. because the limit was given in megabytes
+// instead of bytes or the quota type is inverted
+const softQuotaSmallLimit = 1024 * 1024 * 1024
+
+// softQuotaWarnings warns about soft quotas whose type and limit do not seem
+// to fit together. It is advisory only, Nexus accepts such quotas.
+func softQuotaWarnings(d *schema.ResourceData) diag.Diagnostics {
+	if _, ok := d.GetOk("soft_quota"); !ok {
+		return nil
+	}
+	quotaType := d.Get("soft_quota.0.type").(string)
+	limit := d.Get("soft_quota.0.limit").(int)
+	if limit >= softQuotaSmallLimit {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	switch quotaType {
+	case "spaceRemainingQuota":
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("soft quota of blobstore %q is only violated when the blobstore is almost full", name),
+			Detail:   fmt.Sprintf("A spaceRemainingQuota is violated once less than limit bytes (%d) are left. Use spaceUsedQuota to be alerted once the blobstore holds more than limit bytes.", limit),
+		}}
+	case "spaceUsedQuota":
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("soft quota of blobstore %q is violated once the blobstore holds more than %d bytes", name, limit),
+			Detail:   "The limit is in bytes, not megabytes. Use spaceRemainingQuota to be alerted once less than limit bytes are left.",
+		}}
+	}
+	return nil
+}
+
+// withSoftQuotaWarnings returns a context aware create or update function
+// which appends softQuotaWarnings on success
+func withSoftQuotaWarnings(fn func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := fn(d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return softQuotaWarnings(d)
+	}
+}
@@ -3,9 +3,9 @@ package deprecated
 import (
 	"fmt"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -117,7 +117,7 @@ func DataSourcePrivileges() *schema.Resource {
 }
 
 func dataSourcePrivilegesRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	privileges, err := client.Security.Privilege.List()
 	if err != nil {
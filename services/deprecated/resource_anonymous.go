@@ -1,8 +1,10 @@
 package deprecated
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -62,22 +64,22 @@ func setAnonymousToResourceData(anonymous *security.AnonymousAccessSettings, d *
 }
 
 func resourceAnonymousRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	anonymous, err := client.Security.Anonymous.Read()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading anonymous access configuration: %w", err)
 	}
 
 	return setAnonymousToResourceData(anonymous, d)
 }
 
 func resourceAnonymousUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	anonymous := getAnonymousFromResourceData(d)
 	if err := client.Security.Anonymous.Update(anonymous); err != nil {
-		return err
+		return fmt.Errorf("updating anonymous access configuration: %w", err)
 	}
 
 	return resourceAnonymousRead(d, m)
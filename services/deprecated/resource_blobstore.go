@@ -2,11 +2,10 @@ package deprecated
 
 import (
 	"fmt"
-	"log"
 	"strconv"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,6 +41,7 @@ Use this resource to create a Nexus blobstore.`,
 			"name": {
 				Description: "Blobstore name",
 				Type:        schema.TypeString,
+				ForceNew:    true,
 				Required:    true,
 			},
 			"path": {
@@ -295,12 +295,12 @@ func getBlobstoreFromResourceData(d *schema.ResourceData) blobstore.Legacy {
 }
 
 func resourceBlobstoreCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	bs := getBlobstoreFromResourceData(d)
 
 	if err := client.BlobStore.Legacy.Create(&bs); err != nil {
-		return err
+		return fmt.Errorf("creating blobstore %q: %w", bs.Name, err)
 	}
 
 	d.SetId(bs.Name)
@@ -310,12 +310,11 @@ func resourceBlobstoreCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceBlobstoreRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	bs, err := client.BlobStore.Legacy.Get(d.Id())
-	log.Print(bs)
 	if err != nil {
-		return err
+		return fmt.Errorf("reading blobstore %q: %w", d.Id(), err)
 	}
 
 	if bs == nil {
@@ -346,21 +345,21 @@ func resourceBlobstoreRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceBlobstoreUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	bs := getBlobstoreFromResourceData(d)
 	if err := client.BlobStore.Legacy.Update(d.Id(), bs); err != nil {
-		return err
+		return fmt.Errorf("updating blobstore %q: %w", d.Id(), err)
 	}
 
 	return nil
 }
 
 func resourceBlobstoreDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.BlobStore.Legacy.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting blobstore %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -369,7 +368,7 @@ func resourceBlobstoreDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceBlobstoreExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	bs, err := client.BlobStore.Legacy.Get(d.Id())
 	return bs != nil, err
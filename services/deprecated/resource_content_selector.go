@@ -1,7 +1,9 @@
 package deprecated
 
 import (
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -64,12 +66,12 @@ func setContentSelectorToResourceData(contentSelector *security.ContentSelector,
 }
 
 func resourceContentSelectorCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	contentSelector := getContentSelectorFromResourceData(d)
 
 	if err := client.Security.ContentSelector.Create(contentSelector); err != nil {
-		return err
+		return fmt.Errorf("creating content selector %q: %w", contentSelector.Name, err)
 	}
 
 	d.SetId(contentSelector.Name)
@@ -78,11 +80,11 @@ func resourceContentSelectorCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceContentSelectorRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	contentSelector, err := client.Security.ContentSelector.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading content selector %q: %w", d.Id(), err)
 	}
 
 	if contentSelector == nil {
@@ -94,21 +96,21 @@ func resourceContentSelectorRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceContentSelectorUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	contentSelector := getContentSelectorFromResourceData(d)
 	if err := client.Security.ContentSelector.Update(d.Id(), contentSelector); err != nil {
-		return err
+		return fmt.Errorf("updating content selector %q: %w", d.Id(), err)
 	}
 
 	return resourceContentSelectorRead(d, m)
 }
 
 func resourceContentSelectorDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.Security.ContentSelector.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting content selector %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -117,7 +119,7 @@ func resourceContentSelectorDelete(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceContentSelectorExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	contentSelector, err := client.Security.ContentSelector.Get(d.Id())
 	return contentSelector != nil, err
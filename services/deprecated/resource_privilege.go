@@ -1,9 +1,11 @@
 package deprecated
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -136,12 +138,12 @@ func setPrivilegeToResourceData(privilege *security.Privilege, d *schema.Resourc
 }
 
 func resourcePrivilegeCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	privilege := getPrivilegeFromResourceData(d)
 
 	if err := client.Security.Privilege.Create(privilege); err != nil {
-		return err
+		return fmt.Errorf("creating privilege %q: %w", privilege.Name, err)
 	}
 
 	d.SetId(privilege.Name)
@@ -150,11 +152,11 @@ func resourcePrivilegeCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePrivilegeRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	privilege, err := client.Security.Privilege.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading privilege %q: %w", d.Id(), err)
 	}
 
 	if privilege == nil {
@@ -166,21 +168,21 @@ func resourcePrivilegeRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePrivilegeUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	privilege := getPrivilegeFromResourceData(d)
 	if err := client.Security.Privilege.Update(d.Id(), privilege); err != nil {
-		return err
+		return fmt.Errorf("updating privilege %q: %w", d.Id(), err)
 	}
 
 	return resourcePrivilegeRead(d, m)
 }
 
 func resourcePrivilegeDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.Security.Privilege.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting privilege %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -189,7 +191,7 @@ func resourcePrivilegeDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePrivilegeExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	privilege, err := client.Security.Privilege.Get(d.Id())
 	return privilege != nil, err
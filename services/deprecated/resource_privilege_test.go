@@ -7,7 +7,7 @@ import (
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -72,7 +72,7 @@ func testAccCheckPrivilegeResourceExists(name string, privilege *security.Privil
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		client := acceptance.TestAccProvider.Meta().(*nexus.NexusClient)
+		client := acceptance.TestAccProvider.Meta().(*api.Client)
 		result, err := client.Security.Privilege.Get(rs.Primary.ID)
 		if err != nil {
 			return err
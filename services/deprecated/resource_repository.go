@@ -1,25 +1,32 @@
 package deprecated
 
 import (
+	"context"
+	"fmt"
+
 	"strings"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Query cache age Nexus uses for new nuget proxy repositories
+const nugetDefaultQueryCacheItemMaxAge = 3600
+
 func ResourceRepository() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus Repository.",
 
-		Create: resourceRepositoryCreate,
-		Read:   resourceRepositoryRead,
-		Update: resourceRepositoryUpdate,
-		Delete: resourceRepositoryDelete,
-		Exists: resourceRepositoryExists,
+		CreateContext: resourceRepositoryCreateContext,
+		Read:          resourceRepositoryRead,
+		UpdateContext: resourceRepositoryUpdateContext,
+		Delete:        resourceRepositoryDelete,
+		Exists:        resourceRepositoryExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -35,6 +42,7 @@ func ResourceRepository() *schema.Resource {
 			},
 			"name": {
 				Description: "A unique identifier for this repository",
+				ForceNew:    true,
 				Required:    true,
 				Type:        schema.TypeString,
 			},
@@ -354,9 +362,10 @@ func ResourceRepository() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"query_cache_item_max_age": {
-							Description: "What type of artifacts does this repository store",
-							Required:    true,
-							Type:        schema.TypeInt,
+							Description:  "How long to cache query results from the proxied repository (in seconds). Only used by the `V2` protocol",
+							Required:     true,
+							Type:         schema.TypeInt,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"nuget_version": {
 							Description:  "Nuget protocol version. Possible values: `V2` or `V3` (Default)",
@@ -977,12 +986,12 @@ func flattenRepositoryYum(yum *repository.Yum) []map[string]interface{} {
 }
 
 func resourceRepositoryCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getRepositoryFromResourceData(d)
 
 	if err := client.Repository.Legacy.Create(repo); err != nil {
-		return err
+		return fmt.Errorf("creating repository %q: %w", repo.Name, err)
 	}
 
 	if err := setRepositoryToResourceData(&repo, d); err != nil {
@@ -992,12 +1001,37 @@ func resourceRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 	return resourceRepositoryRead(d, m)
 }
 
+// nugetProxyWarnings warns about a tuned query cache age of a V3 proxy, as
+// Nexus only caches the results of V2 queries
+func nugetProxyWarnings(d *schema.ResourceData) diag.Diagnostics {
+	if _, ok := d.GetOk("nuget_proxy"); !ok {
+		return nil
+	}
+	nugetVersion := d.Get("nuget_proxy.0.nuget_version").(string)
+	queryCacheItemMaxAge := d.Get("nuget_proxy.0.query_cache_item_max_age").(int)
+	if nugetVersion != string(repository.NugetVersion3) || queryCacheItemMaxAge == nugetDefaultQueryCacheItemMaxAge {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("nuget proxy repository %q uses V3, query_cache_item_max_age only affects V2 queries", d.Get("name").(string)),
+		Detail:   fmt.Sprintf("Nexus only caches the results of queries of the V2 protocol. Set nuget_version to \"V2\" if the remote only supports V2, otherwise leave query_cache_item_max_age at the default of %d.", nugetDefaultQueryCacheItemMaxAge),
+	}}
+}
+
+func resourceRepositoryCreateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := resourceRepositoryCreate(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return nugetProxyWarnings(d)
+}
+
 func resourceRepositoryRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Legacy.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading repository %q: %w", d.Id(), err)
 	}
 
 	if repo == nil {
@@ -1009,13 +1043,13 @@ func resourceRepositoryRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := d.Id()
 	repo := getRepositoryFromResourceData(d)
 
 	if err := client.Repository.Legacy.Update(repoName, repo); err != nil {
-		return err
+		return fmt.Errorf("updating repository %q: %w", repoName, err)
 	}
 
 	if err := setRepositoryToResourceData(&repo, d); err != nil {
@@ -1025,14 +1059,24 @@ func resourceRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
 	return resourceRepositoryRead(d, m)
 }
 
+func resourceRepositoryUpdateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := resourceRepositoryUpdate(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return nugetProxyWarnings(d)
+}
+
 func resourceRepositoryDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
-	return client.Repository.Legacy.Delete(d.Id())
+	if err := client.Repository.Legacy.Delete(d.Id()); err != nil {
+		return fmt.Errorf("deleting repository %q: %w", d.Id(), err)
+	}
+	return nil
 }
 
 func resourceRepositoryExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Legacy.Get(d.Id())
 	return repo != nil, err
@@ -5,7 +5,10 @@ import (
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceRepositoryNugetProxy() repository.LegacyRepository {
@@ -53,3 +56,29 @@ func TestAccResourceRepositoryNugetProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceRepositoryNugetProxyValidation(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository"]
+	validate := func(queryCacheItemMaxAge int, nugetVersion string) diag.Diagnostics {
+		return res.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":   "nuget-proxy",
+			"format": repository.RepositoryFormatNuget,
+			"type":   repository.RepositoryTypeProxy,
+			"nuget_proxy": []interface{}{map[string]interface{}{
+				"query_cache_item_max_age": queryCacheItemMaxAge,
+				"nuget_version":            nugetVersion,
+			}},
+		}))
+	}
+
+	assert.False(t, validate(1440, "V3").HasError())
+	assert.False(t, validate(0, "V2").HasError())
+
+	diags := validate(-1, "V3")
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "expected nuget_proxy.0.query_cache_item_max_age to be at least (0)")
+
+	diags = validate(1440, "v3")
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "expected nuget_proxy.0.nuget_version to be one of")
+}
@@ -2,13 +2,18 @@ package deprecated_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strconv"
+	"testing"
 	"text/template"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -305,3 +310,28 @@ func resourceRepositoryTypeProxyTestCheckFunc(repo repository.LegacyRepository)
 		resource.TestCheckResourceAttr(resName, "proxy.0.metadata_max_age", strconv.Itoa(repo.Proxy.MetadataMaxAge)),
 	)
 }
+
+func TestResourceRepositoryFormatForceNew(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository"]
+	state := &terraform.InstanceState{
+		ID: "releases",
+		Attributes: map[string]string{
+			"id":     "releases",
+			"format": repository.RepositoryFormatMaven2,
+			"name":   "releases",
+			"online": "true",
+			"type":   repository.RepositoryTypeHosted,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"format": repository.RepositoryFormatNPM,
+		"name":   "releases",
+		"online": true,
+		"type":   repository.RepositoryTypeHosted,
+	})
+
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["format"].RequiresNew)
+}
@@ -1,11 +1,13 @@
 package deprecated
 
 import (
+	"fmt"
+
 	"strings"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -81,10 +83,10 @@ func getRoleFromResourceData(d *schema.ResourceData) security.Role {
 }
 
 func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	role := getRoleFromResourceData(d)
 	if err := client.Security.Role.Create(role); err != nil {
-		return err
+		return fmt.Errorf("creating role %q: %w", role.ID, err)
 	}
 
 	d.SetId(role.ID)
@@ -92,11 +94,11 @@ func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	role, err := client.Security.Role.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading role %q: %w", d.Id(), err)
 	}
 
 	if role == nil {
@@ -114,22 +116,22 @@ func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	roleID := d.Get("roleid").(string)
 
 	role := getRoleFromResourceData(d)
 	if err := client.Security.Role.Update(roleID, role); err != nil {
-		return err
+		return fmt.Errorf("updating role %q: %w", roleID, err)
 	}
 
 	return resourceRoleRead(d, m)
 }
 
 func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.Security.Role.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting role %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -137,7 +139,7 @@ func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceRoleExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	role, err := client.Security.Role.Get(d.Id())
 	return role != nil, err
@@ -1,7 +1,9 @@
 package deprecated
 
 import (
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -88,11 +90,11 @@ func getUserFromResourceData(d *schema.ResourceData) security.User {
 }
 
 func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	user := getUserFromResourceData(d)
 
 	if err := client.Security.User.Create(user); err != nil {
-		return err
+		return fmt.Errorf("creating user %q: %w", user.UserID, err)
 	}
 
 	d.SetId(user.UserID)
@@ -100,11 +102,11 @@ func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceUserRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	user, err := client.Security.User.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading user %q: %w", d.Id(), err)
 	}
 
 	if user == nil {
@@ -123,29 +125,29 @@ func resourceUserRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceUserUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if d.HasChange("password") {
 		password := d.Get("password").(string)
 		if err := client.Security.User.ChangePassword(d.Id(), password); err != nil {
-			return err
+			return fmt.Errorf("changing password of user %q: %w", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("firstname") || d.HasChange("lastname") || d.HasChange("email") || d.HasChange("status") || d.HasChange("roles") {
 		user := getUserFromResourceData(d)
 		if err := client.Security.User.Update(d.Id(), user); err != nil {
-			return err
+			return fmt.Errorf("updating user %q: %w", d.Id(), err)
 		}
 	}
 	return resourceUserRead(d, m)
 }
 
 func resourceUserDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.Security.User.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting user %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -153,7 +155,7 @@ func resourceUserDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceUserExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	user, err := client.Security.User.Get(d.Id())
 	return user != nil, err
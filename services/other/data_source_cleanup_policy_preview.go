@@ -0,0 +1,116 @@
+package other
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceCleanupPolicyPreview() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to preview which components of a repository a cleanup policy would delete.
+
+This is a dry run, nothing is deleted. Use it to check the impact of a policy, e.g. together with a check or a precondition, before the policy is attached to a repository.`,
+
+		Read: dataSourceCleanupPolicyPreviewRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"policy": {
+				Description: "The name of the cleanup policy",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"repository": {
+				Description: "The name of the repository to preview the cleanup for",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"sample_size": {
+				Default:      10,
+				Description:  "The maximum number of components to return in `components`",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"component_count": {
+				Computed:    true,
+				Description: "The number of components the cleanup policy would delete",
+				Type:        schema.TypeInt,
+			},
+			"components": {
+				Computed:    true,
+				Description: "A sample of the components the cleanup policy would delete",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Computed:    true,
+							Description: "The group of the component",
+							Type:        schema.TypeString,
+						},
+						"name": {
+							Computed:    true,
+							Description: "The name of the component",
+							Type:        schema.TypeString,
+						},
+						"version": {
+							Computed:    true,
+							Description: "The version of the component",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCleanupPolicyPreviewRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewCleanupPolicyService(client)
+	policyName := d.Get("policy").(string)
+	repositoryName := d.Get("repository").(string)
+	sampleSize := d.Get("sample_size").(int)
+
+	policy, err := service.Get(policyName)
+	if err != nil {
+		return fmt.Errorf("reading cleanup policy %q: %w", policyName, err)
+	}
+	if policy == nil {
+		return fmt.Errorf("reading cleanup policy %q: cleanup policy not found", policyName)
+	}
+
+	// A page needs room for at least one component, even if only the count is of interest
+	limit := sampleSize
+	if limit < 1 {
+		limit = 1
+	}
+	count, components, err := service.Preview(repositoryName, *policy, limit)
+	if err != nil {
+		return fmt.Errorf("previewing cleanup policy %q for repository %q: %w", policyName, repositoryName, err)
+	}
+	if len(components) > sampleSize {
+		components = components[:sampleSize]
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", policyName, repositoryName))
+	d.Set("component_count", count)
+	d.Set("components", flattenCleanupPreviewComponents(components))
+
+	return nil
+}
+
+func flattenCleanupPreviewComponents(components []api.CleanupPreviewComponent) []interface{} {
+	result := make([]interface{}, len(components))
+	for i, component := range components {
+		result[i] = map[string]interface{}{
+			"group":   component.Group,
+			"name":    component.Name,
+			"version": component.Version,
+		}
+	}
+	return result
+}
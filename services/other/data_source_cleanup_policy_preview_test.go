@@ -0,0 +1,91 @@
+package other_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceCleanupPolicyPreview(t *testing.T) {
+	components := []map[string]string{
+		{"repositoryName": "maven-snapshots", "group": "com.example", "name": "app", "version": "1.0-SNAPSHOT"},
+		{"repositoryName": "maven-snapshots", "group": "com.example", "name": "app", "version": "1.1-SNAPSHOT"},
+		{"repositoryName": "maven-snapshots", "group": "com.example", "name": "lib", "version": "2.0-SNAPSHOT"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/service/extdirect" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var request struct {
+			Action string `json:"action"`
+			Method string `json:"method"`
+			Data   []struct {
+				Limit  int `json:"limit"`
+				Filter []struct {
+					Property string `json:"property"`
+					Value    string `json:"value"`
+				} `json:"filter"`
+			} `json:"data"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "cleanup_CleanupPolicy", request.Action)
+
+		result := map[string]interface{}{"success": true}
+		switch request.Method {
+		case "readAll":
+			result["data"] = []interface{}{
+				map[string]interface{}{"name": "old-snapshots", "format": "maven2", "criteriaLastBlobUpdated": 30, "criteriaReleaseType": "PRERELEASES"},
+			}
+		case "previewCleanup":
+			filters := map[string]string{}
+			for _, filter := range request.Data[0].Filter {
+				filters[filter.Property] = filter.Value
+			}
+			assert.JSONEq(t, `{"name":"old-snapshots","format":"maven2","criteriaLastBlobUpdated":30,"criteriaReleaseType":"PRERELEASES"}`, filters["cleanupPolicy"])
+
+			matching := []map[string]string{}
+			if filters["repositoryName"] == "maven-snapshots" {
+				matching = components
+			}
+			page := matching
+			if len(page) > request.Data[0].Limit {
+				page = page[:request.Data[0].Limit]
+			}
+			result["total"] = len(matching)
+			result["data"] = page
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"type": "rpc", "result": result})
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_cleanup_policy_preview"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"policy": "old-snapshots", "repository": "maven-snapshots", "sample_size": 2})
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, 3, resourceData.Get("component_count"))
+	assert.Equal(t, 2, resourceData.Get("components.#"))
+	assert.Equal(t, "1.1-SNAPSHOT", resourceData.Get("components.1.version"))
+
+	// No matching components
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"policy": "old-snapshots", "repository": "maven-releases"})
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, 0, resourceData.Get("component_count"))
+	assert.Equal(t, 0, resourceData.Get("components.#"))
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"policy": "missing", "repository": "maven-snapshots"})
+	assert.ErrorContains(t, res.Read(resourceData, nexusClient), `reading cleanup policy "missing": cleanup policy not found`)
+}
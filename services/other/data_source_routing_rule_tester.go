@@ -0,0 +1,61 @@
+package other
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRoutingRuleTest() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to test whether a routing rule allows or blocks a request path.
+
+This helps to validate the matchers of a rule, e.g. together with a check or a precondition, before the rule is attached to repositories.`,
+
+		Read: dataSourceRoutingRuleTestRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"rule": {
+				Description: "The name of the routing rule",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"path": {
+				Description: "The request path to test, e.g. `/com/example/app/1.0/app-1.0.jar`",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"allowed": {
+				Computed:    true,
+				Description: "Whether the routing rule allows a request for the path",
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+}
+
+func dataSourceRoutingRuleTestRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	ruleName := d.Get("rule").(string)
+	path := d.Get("path").(string)
+
+	rule, err := client.RoutingRule.Get(ruleName)
+	if err != nil {
+		return fmt.Errorf("reading routing rule %q: %w", ruleName, err)
+	}
+	if rule == nil {
+		return fmt.Errorf("reading routing rule %q: routing rule not found", ruleName)
+	}
+
+	allowed, err := api.NewRoutingRuleService(client).Test(*rule, path)
+	if err != nil {
+		return fmt.Errorf("testing routing rule %q with path %q: %w", ruleName, path, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", ruleName, path))
+	d.Set("allowed", allowed)
+
+	return nil
+}
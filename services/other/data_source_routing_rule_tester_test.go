@@ -0,0 +1,62 @@
+package other_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceRoutingRuleTest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/routing-rules/stop-leaks":
+			fmt.Fprint(w, `{"name":"stop-leaks","mode":"BLOCK","matchers":["^/com/example/.*"]}`)
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `Did not find a routing rule with the name 'missing'`)
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/internal/ui/routing-rules/test":
+			// Evaluate the rule like Nexus does
+			var request struct {
+				Mode     string   `json:"mode"`
+				Matchers []string `json:"matchers"`
+				Path     string   `json:"path"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			matches := false
+			for _, matcher := range request.Matchers {
+				matches = matches || regexp.MustCompile(matcher).MatchString(request.Path)
+			}
+			json.NewEncoder(w).Encode(matches == (request.Mode == "ALLOW"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_routing_rule_test"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"rule": "stop-leaks", "path": "/com/example/app/1.0/app-1.0.jar"})
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, false, resourceData.Get("allowed"))
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"rule": "stop-leaks", "path": "/org/apache/commons/commons-lang3/3.12.0/commons-lang3-3.12.0.jar"})
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, true, resourceData.Get("allowed"))
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"rule": "missing", "path": "/"})
+	assert.ErrorContains(t, res.Read(resourceData, nexusClient), `reading routing rule "missing"`)
+}
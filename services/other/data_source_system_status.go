@@ -0,0 +1,99 @@
+package other
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const systemStatusID = "status"
+
+func DataSourceSystemStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to get the edition, version and health of Nexus.
+
+This allows to create PRO-only resources like user tokens conditionally. The user needs the privilege ` + "`nx-metrics-all`" + `.`,
+
+		Read: dataSourceSystemStatusRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"edition": {
+				Computed:    true,
+				Description: "The edition of Nexus. Possible values: `OSS` or `PRO`",
+				Type:        schema.TypeString,
+			},
+			"version": {
+				Computed:    true,
+				Description: "The version of Nexus, e.g. `3.38.1-01`",
+				Type:        schema.TypeString,
+			},
+			"healthy": {
+				Computed:    true,
+				Description: "Whether all health checks of Nexus pass",
+				Type:        schema.TypeBool,
+			},
+			"checks": {
+				Computed:    true,
+				Description: "The results of the health checks",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Computed:    true,
+							Description: "The name of the health check",
+							Type:        schema.TypeString,
+						},
+						"healthy": {
+							Computed:    true,
+							Description: "Whether the health check passes",
+							Type:        schema.TypeBool,
+						},
+						"message": {
+							Computed:    true,
+							Description: "The message of the health check",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSystemStatusRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	status, err := api.NewSystemStatusService(client).Get()
+	if err != nil {
+		return fmt.Errorf("reading system status: %w", err)
+	}
+
+	healthy := true
+	checks := make([]map[string]interface{}, 0, len(status.Checks))
+	for _, check := range status.Checks {
+		healthy = healthy && check.Healthy
+		checks = append(checks, map[string]interface{}{
+			"name":    check.Name,
+			"healthy": check.Healthy,
+			"message": check.Message,
+		})
+	}
+
+	resourceData.SetId(systemStatusID)
+	if err := resourceData.Set("edition", status.Edition); err != nil {
+		return err
+	}
+	if err := resourceData.Set("version", status.Version); err != nil {
+		return err
+	}
+	if err := resourceData.Set("healthy", healthy); err != nil {
+		return err
+	}
+	if err := resourceData.Set("checks", checks); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package other_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceSystemStatus(t *testing.T) {
+	resName := "data.nexus_system_status.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nexus_system_status" "acceptance" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "edition", "OSS"),
+					resource.TestCheckResourceAttrSet(resName, "version"),
+					resource.TestCheckResourceAttrSet(resName, "healthy"),
+					resource.TestCheckResourceAttrSet(resName, "checks.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceSystemStatus(t *testing.T) {
+	statusCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/status/check" {
+			w.Header().Set("Server", "Nexus/3.38.1-01 (PRO)")
+			w.WriteHeader(statusCode)
+			fmt.Fprint(w, `{
+				"Blob Stores": {"healthy": true, "message": "All blob stores are ready"},
+				"Available CPUs": {"healthy": false, "message": "The host system is allocating a maximum of 2 cores to the application."}
+			}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_system_status"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, "PRO", resourceData.Get("edition"))
+	assert.Equal(t, "3.38.1-01", resourceData.Get("version"))
+	assert.Equal(t, false, resourceData.Get("healthy"))
+	assert.Equal(t, 2, resourceData.Get("checks.#"))
+	assert.Equal(t, "Available CPUs", resourceData.Get("checks.0.name"))
+	assert.Equal(t, false, resourceData.Get("checks.0.healthy"))
+	assert.Equal(t, "Blob Stores", resourceData.Get("checks.1.name"))
+	assert.Equal(t, "All blob stores are ready", resourceData.Get("checks.1.message"))
+
+	statusCode = http.StatusUnauthorized
+	err := res.Read(schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{}), nexusClient)
+	assert.EqualError(t, err, "reading system status: authentication failed, check username and password: HTTP: 401")
+
+	server.Close()
+	err = res.Read(schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{}), nexusClient)
+	assert.Regexp(t, `^reading system status: could not connect to Nexus: .*connection refused`, err)
+}
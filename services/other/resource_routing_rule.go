@@ -1,11 +1,13 @@
 package other
 
 import (
+	"fmt"
+
 	"strings"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	nexusSchema "github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -70,11 +72,11 @@ func getRoutingRuleFromResourceData(d *schema.ResourceData) nexusSchema.RoutingR
 }
 
 func resourceRoutingRuleCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	rule := getRoutingRuleFromResourceData(d)
 
 	if err := client.RoutingRule.Create(&rule); err != nil {
-		return err
+		return fmt.Errorf("creating routing rule %q: %w", rule.Name, err)
 	}
 
 	d.SetId(rule.Name)
@@ -82,11 +84,11 @@ func resourceRoutingRuleCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceRoutingRuleRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	rule, err := client.RoutingRule.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading routing rule %q: %w", d.Id(), err)
 	}
 
 	if rule == nil {
@@ -103,21 +105,21 @@ func resourceRoutingRuleRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceRoutingRuleUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	rule := getRoutingRuleFromResourceData(d)
 	if err := client.RoutingRule.Update(&rule); err != nil {
-		return err
+		return fmt.Errorf("updating routing rule %q: %w", rule.Name, err)
 	}
 
 	return resourceRoutingRuleRead(d, m)
 }
 
 func resourceRoutingRuleDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.RoutingRule.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting routing rule %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -125,7 +127,7 @@ func resourceRoutingRuleDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceRoutingRuleExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	rule, err := client.RoutingRule.Get(d.Id())
 	return rule != nil, err
@@ -0,0 +1,176 @@
+package other
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	scheduledTaskRunOnConflictFail = "FAIL"
+	scheduledTaskRunOnConflictWait = "WAIT"
+)
+
+func ResourceScheduledTaskRun() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to run an existing scheduled task of Nexus on demand.
+
+The task is run once when the resource is created. Change ` + "`triggers`" + ` to run it again. Destroying the resource does not change anything in Nexus.`,
+
+		CreateContext: resourceScheduledTaskRunCreate,
+		Read:          resourceScheduledTaskRunRead,
+		Delete:        resourceScheduledTaskRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"task": {
+				Description: "Name or ID of the task to run",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"on_conflict": {
+				Default:      scheduledTaskRunOnConflictFail,
+				Description:  "What to do if the task is already running. `FAIL` reports an error, `WAIT` waits for the running task to finish before running it again",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{scheduledTaskRunOnConflictFail, scheduledTaskRunOnConflictWait}, false),
+			},
+			"wait_for_completion": {
+				Default:     true,
+				Description: "Whether to wait until the run has finished",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"triggers": {
+				Description: "Arbitrary values which run the task again when they change",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+			},
+			"task_id": {
+				Computed:    true,
+				Description: "ID of the task which was run",
+				Type:        schema.TypeString,
+			},
+			"last_run_result": {
+				Computed:    true,
+				Description: "Result of the run, e.g. `OK`. Only known if `wait_for_completion` is set",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// findTask returns the task with the given ID or name
+func findTask(service *api.TaskService, nameOrID string) (*api.Task, error) {
+	tasks, err := service.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range tasks {
+		if tasks[i].ID == nameOrID || tasks[i].Name == nameOrID {
+			return &tasks[i], nil
+		}
+	}
+	return nil, fmt.Errorf("task %q not found", nameOrID)
+}
+
+func resourceScheduledTaskRunCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client)
+	service := api.NewTaskService(client)
+	nameOrID := resourceData.Get("task").(string)
+	timeout := resourceData.Timeout(schema.TimeoutCreate)
+
+	task, err := findTask(service, nameOrID)
+	if err != nil {
+		return diag.Errorf("running task %q: %v", nameOrID, err)
+	}
+
+	if task.CurrentState == api.TaskStateRunning {
+		if resourceData.Get("on_conflict").(string) != scheduledTaskRunOnConflictWait {
+			return diag.Errorf("running task %q: the task is already running", nameOrID)
+		}
+		task, err = waitForTask(ctx, service, task.ID, timeout, func(task *api.Task) bool {
+			return task.CurrentState != api.TaskStateRunning
+		})
+		if err != nil {
+			return diag.Errorf("waiting for running task %q: %v", nameOrID, err)
+		}
+	}
+
+	previousRun := task.LastRun
+	if err := service.Run(task.ID); err != nil {
+		return diag.Errorf("running task %q: %v", nameOrID, err)
+	}
+	resourceData.SetId(task.ID)
+	resourceData.Set("task_id", task.ID)
+
+	if !resourceData.Get("wait_for_completion").(bool) {
+		return nil
+	}
+
+	// The run has finished once the task reports a new last run
+	task, err = waitForTask(ctx, service, task.ID, timeout, func(task *api.Task) bool {
+		return task.CurrentState != api.TaskStateRunning && task.LastRun != previousRun
+	})
+	if err != nil {
+		return diag.Errorf("waiting for task %q: %v", nameOrID, err)
+	}
+	resourceData.Set("last_run_result", task.LastRunResult)
+
+	if task.LastRunResult == api.TaskResultFailed {
+		return diag.Errorf("running task %q: task %s failed: %s", nameOrID, task.ID, task.Message)
+	}
+	return nil
+}
+
+// waitForTask polls the task until finished returns true
+func waitForTask(ctx context.Context, service *api.TaskService, taskID string, timeout time.Duration, finished func(*api.Task) bool) (*api.Task, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			task, err := service.Get(taskID)
+			if err != nil {
+				return nil, "", err
+			}
+			if task == nil {
+				return nil, "", fmt.Errorf("task %s not found", taskID)
+			}
+			if !finished(task) {
+				return task, "pending", nil
+			}
+			return task, "done", nil
+		},
+		Timeout: timeout,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*api.Task), nil
+}
+
+func resourceScheduledTaskRunRead(resourceData *schema.ResourceData, m interface{}) error {
+	// A run is a one-shot action, there is no remote state to refresh
+	return nil
+}
+
+func resourceScheduledTaskRunDelete(resourceData *schema.ResourceData, m interface{}) error {
+	return nil
+}
@@ -0,0 +1,90 @@
+package other_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeScheduledTaskServer simulates a single task in the given state. A run
+// keeps it running for one poll and then finishes it with result OK.
+func fakeScheduledTaskServer(initialState string, runs *int) *httptest.Server {
+	var mutex sync.Mutex
+	finished := 0
+	task := map[string]interface{}{
+		"id":            "task-1",
+		"name":          "Rebuild index",
+		"currentState":  initialState,
+		"lastRun":       "2022-06-01T10:00:00.000+00:00",
+		"lastRunResult": "OK",
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/tasks":
+			json.NewEncoder(w).Encode(map[string]interface{}{"items": []interface{}{task}})
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(task)
+			// Whatever is running finishes after this poll
+			if task["currentState"] == "RUNNING" {
+				task["currentState"] = "WAITING"
+				finished++
+				task["lastRun"] = fmt.Sprintf("2022-06-01T%02d:00:00.000+00:00", 10+finished)
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/tasks/task-1/run":
+			*runs++
+			task["currentState"] = "RUNNING"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestResourceScheduledTaskRun(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_scheduled_task_run"]
+
+	runs := 0
+	server := fakeScheduledTaskServer("WAITING", &runs)
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"task": "Rebuild index"})
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 1, runs)
+	assert.Equal(t, "task-1", resourceData.Id())
+	assert.Equal(t, "OK", resourceData.Get("last_run_result"))
+}
+
+func TestResourceScheduledTaskRunConflict(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_scheduled_task_run"]
+
+	runs := 0
+	server := fakeScheduledTaskServer("RUNNING", &runs)
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	diags := res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"task": "task-1"}), nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `running task "task-1": the task is already running`, diags[0].Summary)
+	assert.Equal(t, 0, runs)
+
+	// Waiting runs the task once the current run has finished
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"task": "task-1", "on_conflict": "WAIT"})
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 1, runs)
+}
@@ -1,8 +1,10 @@
 package other
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	nexusSchema "github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,15 +53,15 @@ func getScriptFromResourceData(d *schema.ResourceData) nexusSchema.Script {
 }
 
 func resourceScriptCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	script := getScriptFromResourceData(d)
 
 	if err := client.Script.Create(&script); err != nil {
-		return err
+		return fmt.Errorf("creating script %q: %w", script.Name, err)
 	}
 	// TODO: It should be possible to configure whether to run script or not
 	if err := client.Script.Run(script.Name); err != nil {
-		return err
+		return fmt.Errorf("running script %q: %w", script.Name, err)
 	}
 
 	d.SetId(script.Name)
@@ -67,11 +69,11 @@ func resourceScriptCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceScriptRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	script, err := client.Script.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading script %q: %w", d.Id(), err)
 	}
 
 	if script == nil {
@@ -87,16 +89,16 @@ func resourceScriptRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceScriptUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if d.HasChange("content") || d.HasChange("type") {
 		script := getScriptFromResourceData(d)
 		if err := client.Script.Update(&script); err != nil {
-			return err
+			return fmt.Errorf("updating script %q: %w", script.Name, err)
 		}
 
 		if err := client.Script.Run(script.Name); err != nil {
-			return err
+			return fmt.Errorf("running script %q: %w", script.Name, err)
 		}
 	}
 
@@ -104,10 +106,10 @@ func resourceScriptUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceScriptDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.Script.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting script %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -115,7 +117,7 @@ func resourceScriptDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceScriptExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	script, err := client.Script.Get(d.Id())
 	return script != nil, err
@@ -0,0 +1,129 @@
+package other
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	baseURLCapabilityTypeID = "baseurl"
+)
+
+func ResourceSystemBaseURL() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to set the base URL of Nexus.
+
+This resource manages the "Base URL" capability of Nexus. There is only one base URL per Nexus instance.`,
+
+		Create: resourceSystemBaseURLCreate,
+		Read:   resourceSystemBaseURLRead,
+		Update: resourceSystemBaseURLUpdate,
+		Delete: resourceSystemBaseURLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"base_url": {
+				Description:  "The base URL of Nexus, e.g. `https://nexus.example.com`",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+	}
+}
+
+func resourceSystemBaseURLCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewCapabilityService(client)
+
+	capability, err := service.GetByType(baseURLCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("creating base URL: %w", err)
+	}
+
+	// The capability may already exist, e.g. when it has been set in the UI
+	if capability != nil {
+		capability.Enabled = true
+		capability.Properties = map[string]string{"url": d.Get("base_url").(string)}
+		if err := service.Update(*capability); err != nil {
+			return fmt.Errorf("creating base URL: %w", err)
+		}
+	} else {
+		if _, err := service.Create(api.Capability{
+			TypeID:     baseURLCapabilityTypeID,
+			Enabled:    true,
+			Properties: map[string]string{"url": d.Get("base_url").(string)},
+		}); err != nil {
+			return fmt.Errorf("creating base URL: %w", err)
+		}
+	}
+
+	d.SetId(baseURLCapabilityTypeID)
+	return resourceSystemBaseURLRead(d, m)
+}
+
+func resourceSystemBaseURLRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	capability, err := api.NewCapabilityService(client).GetByType(baseURLCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("reading base URL: %w", err)
+	}
+
+	if capability == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(baseURLCapabilityTypeID)
+	d.Set("base_url", capability.Properties["url"])
+
+	return nil
+}
+
+func resourceSystemBaseURLUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewCapabilityService(client)
+
+	capability, err := service.GetByType(baseURLCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("updating base URL: %w", err)
+	}
+	if capability == nil {
+		return fmt.Errorf("updating base URL: capability %q not found", baseURLCapabilityTypeID)
+	}
+
+	capability.Enabled = true
+	capability.Properties = map[string]string{"url": d.Get("base_url").(string)}
+	if err := service.Update(*capability); err != nil {
+		return fmt.Errorf("updating base URL: %w", err)
+	}
+
+	return resourceSystemBaseURLRead(d, m)
+}
+
+func resourceSystemBaseURLDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewCapabilityService(client)
+
+	capability, err := service.GetByType(baseURLCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("deleting base URL: %w", err)
+	}
+
+	if capability != nil {
+		if err := service.Delete(capability.ID); err != nil {
+			return fmt.Errorf("deleting base URL: %w", err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,136 @@
+package other_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/internal/testhelper"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceSystemBaseURL(t *testing.T) {
+	resName := "nexus_system_baseurl.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "nexus_system_baseurl" "acceptance" {
+	base_url = "https://nexus.example.com"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "baseurl"),
+					resource.TestCheckResourceAttr(resName, "base_url", "https://nexus.example.com"),
+					func(s *terraform.State) error {
+						nexusClient := acceptance.TestAccProvider.Meta().(*api.Client)
+						capability, err := api.NewCapabilityService(nexusClient).GetByType("baseurl")
+						if err != nil {
+							return err
+						}
+						if capability == nil || capability.Properties["url"] != "https://nexus.example.com" {
+							return fmt.Errorf("base URL capability not found or not set: %v", capability)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateId:     "baseurl",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceSystemBaseURL(t *testing.T) {
+	capabilities := []api.Capability{}
+	server := testhelper.FakeCapabilityServer(&capabilities)
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_system_baseurl"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"base_url": "https://nexus.example.com",
+	})
+
+	err := res.Create(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "baseurl", resourceData.Id())
+	assert.Len(t, capabilities, 1)
+	assert.Equal(t, "baseurl", capabilities[0].TypeID)
+	assert.True(t, capabilities[0].Enabled)
+	assert.Equal(t, "https://nexus.example.com", capabilities[0].Properties["url"])
+
+	err = res.Delete(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Empty(t, capabilities)
+
+	_, errs := res.Schema["base_url"].ValidateFunc("nexus.example.com", "base_url")
+	assert.NotEmpty(t, errs)
+}
+
+func TestResourceSystemBaseURLUnsupportedNexusVersion(t *testing.T) {
+	capabilities := []api.Capability{}
+	server := testhelper.FakeCapabilityServer(&capabilities)
+	defer server.Close()
+
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_system_baseurl"]
+	for _, version := range []string{"3.30.0", "4.0.0"} {
+		nexusClient := api.NewClient(client.Config{
+			URL:      server.URL,
+			Username: "admin",
+			Password: "admin123",
+		}, nil)
+		assert.NoError(t, api.PinServerVersion(nexusClient, version))
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"base_url": "https://nexus.example.com",
+		})
+
+		err := res.Create(resourceData, nexusClient)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), fmt.Sprintf("of the internal API of the Nexus UI is supported for Nexus 3.37 up to 3.x only, but Nexus %s is used", version))
+		}
+		assert.Empty(t, capabilities)
+	}
+
+	// The detected version is checked before the internal API is called
+	detectingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/rest/v1/status" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Server", "Nexus/3.20.1-01 (OSS)")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer detectingServer.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      detectingServer.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"base_url": "https://nexus.example.com",
+	})
+	err := res.Create(resourceData, nexusClient)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "but Nexus 3.20.1-01 is used. Set nexus_version of the provider if the detected version is wrong")
+	}
+}
@@ -0,0 +1,106 @@
+package other
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	outreachCapabilityTypeID = "OutreachManagementCapability"
+)
+
+func ResourceSystemOutreach() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to enable or disable the outreach of Nexus.
+
+This resource manages the "Outreach: Management" capability of Nexus. On destroy the capability is left in its current state.`,
+
+		Create: resourceSystemOutreachCreate,
+		Read:   resourceSystemOutreachRead,
+		Update: resourceSystemOutreachUpdate,
+		Delete: resourceSystemOutreachDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"enabled": {
+				Computed:    true,
+				Description: "Whether the outreach capability is enabled. Defaults to the current state of Nexus",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+}
+
+func setSystemOutreachEnabled(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewCapabilityService(client)
+
+	capability, err := service.GetByType(outreachCapabilityTypeID)
+	if err != nil {
+		return err
+	}
+
+	enabled, ok := d.GetOkExists("enabled")
+	if !ok {
+		// Nothing configured, reflect the state of Nexus
+		return nil
+	}
+
+	if capability == nil {
+		_, err := service.Create(api.Capability{
+			TypeID:     outreachCapabilityTypeID,
+			Enabled:    enabled.(bool),
+			Properties: map[string]string{},
+		})
+		return err
+	}
+
+	if capability.Enabled == enabled.(bool) {
+		return nil
+	}
+	capability.Enabled = enabled.(bool)
+	return service.Update(*capability)
+}
+
+func resourceSystemOutreachCreate(d *schema.ResourceData, m interface{}) error {
+	if err := setSystemOutreachEnabled(d, m); err != nil {
+		return fmt.Errorf("creating outreach: %w", err)
+	}
+
+	d.SetId(outreachCapabilityTypeID)
+	return resourceSystemOutreachRead(d, m)
+}
+
+func resourceSystemOutreachRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	capability, err := api.NewCapabilityService(client).GetByType(outreachCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("reading outreach: %w", err)
+	}
+
+	d.SetId(outreachCapabilityTypeID)
+	d.Set("enabled", capability != nil && capability.Enabled)
+
+	return nil
+}
+
+func resourceSystemOutreachUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := setSystemOutreachEnabled(d, m); err != nil {
+		return fmt.Errorf("updating outreach: %w", err)
+	}
+
+	return resourceSystemOutreachRead(d, m)
+}
+
+func resourceSystemOutreachDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
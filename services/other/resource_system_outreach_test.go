@@ -0,0 +1,78 @@
+package other_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/internal/testhelper"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceSystemOutreach(t *testing.T) {
+	resName := "nexus_system_outreach.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "nexus_system_outreach" "acceptance" {
+	enabled = false
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "enabled", "false"),
+					func(s *terraform.State) error {
+						nexusClient := acceptance.TestAccProvider.Meta().(*api.Client)
+						capability, err := api.NewCapabilityService(nexusClient).GetByType("OutreachManagementCapability")
+						if err != nil {
+							return err
+						}
+						if capability != nil && capability.Enabled {
+							return fmt.Errorf("outreach capability is still enabled")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceSystemOutreachToggleOff(t *testing.T) {
+	capabilities := []api.Capability{
+		{ID: "capability-1", TypeID: "OutreachManagementCapability", Enabled: true, Properties: map[string]string{}},
+	}
+	server := testhelper.FakeCapabilityServer(&capabilities)
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_system_outreach"]
+
+	// Without enabled the state of Nexus is reflected
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	err := res.Create(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, true, resourceData.Get("enabled"))
+	assert.True(t, capabilities[0].Enabled)
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"enabled": false,
+	})
+	err = res.Create(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "OutreachManagementCapability", resourceData.Id())
+	assert.Equal(t, false, resourceData.Get("enabled"))
+	assert.Len(t, capabilities, 1)
+	assert.False(t, capabilities[0].Enabled)
+}
@@ -0,0 +1,59 @@
+package other
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSystemSMTPTest() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to send a test email through the email server configured in Nexus.
+
+The email is sent once when the resource is created. Change ` + "`triggers`" + `, e.g. to the settings of the email server, to send it again. Destroying the resource does not change anything in Nexus.`,
+
+		Create: resourceSystemSMTPTestCreate,
+		Read:   resourceSystemSMTPTestRead,
+		Delete: resourceSystemSMTPTestDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"to_address": {
+				Description: "The address to send the test email to",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"triggers": {
+				Description: "Arbitrary values which send the test email again when they change",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+			},
+		},
+	}
+}
+
+func resourceSystemSMTPTestCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	toAddress := resourceData.Get("to_address").(string)
+
+	if err := api.NewEmailService(client).Verify(toAddress); err != nil {
+		return fmt.Errorf("sending test email to %q: %w", toAddress, err)
+	}
+
+	resourceData.SetId(toAddress)
+	return nil
+}
+
+func resourceSystemSMTPTestRead(resourceData *schema.ResourceData, m interface{}) error {
+	// Sending a test email is a one-shot action, there is no remote state to refresh
+	return nil
+}
+
+func resourceSystemSMTPTestDelete(resourceData *schema.ResourceData, m interface{}) error {
+	return nil
+}
@@ -0,0 +1,48 @@
+package other_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceSystemSMTPTest(t *testing.T) {
+	recipients := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/service/rest/v1/email/verify" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var address string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&address))
+		recipients = append(recipients, address)
+		if address == "nobody@example.com" {
+			fmt.Fprint(w, `{"success":false,"reason":"550 5.1.1 <nobody@example.com>: Recipient address rejected"}`)
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"reason":null}`)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_system_smtp_test"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"to_address": "admin@example.com"})
+	assert.NoError(t, res.Create(resourceData, nexusClient))
+	assert.Equal(t, "admin@example.com", resourceData.Id())
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"to_address": "nobody@example.com"})
+	err := res.Create(resourceData, nexusClient)
+	assert.EqualError(t, err, `sending test email to "nobody@example.com": could not send test email to 'nobody@example.com': 550 5.1.1 <nobody@example.com>: Recipient address rejected`)
+	assert.Empty(t, resourceData.Id())
+
+	assert.Equal(t, []string{"admin@example.com", "nobody@example.com"}, recipients)
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// createWithAdoption wraps the create function of a repository to take over
+// an existing repository of the same name, format and type if adopt_existing
+// is set. The adopted repository is read into the state as is. Only a create
+// which Nexus rejects because the name is taken is followed by an adoption.
+func createWithAdoption(format string, repositoryType string, create schema.CreateFunc, read schema.ReadFunc) schema.CreateFunc {
+	return func(resourceData *schema.ResourceData, m interface{}) error {
+		err := create(resourceData, m)
+		if !isRepositoryExists(err) || !resourceData.Get("adopt_existing").(bool) {
+			return err
+		}
+
+		name := resourceData.Get("name").(string)
+		if formatErr := checkRepositoryFormat(m.(*api.Client), name, format, repositoryType); formatErr != nil {
+			return fmt.Errorf("%w (adopting repository %q: %v)", err, name, formatErr)
+		}
+
+		log.Printf("[INFO] Adopting existing %s %s repository %q", format, repositoryType, name)
+		resourceData.SetId(name)
+		return read(resourceData, m)
+	}
+}
+
+// isRepositoryExists returns whether a create failed because a repository of
+// the same name exists. Nexus rejects the create with HTTP 400 in that case.
+func isRepositoryExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "http: 400") &&
+		(strings.Contains(message, "same name exists") || strings.Contains(message, "already exists"))
+}
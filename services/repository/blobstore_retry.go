@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	blobStoreRetryInitialBackoff = 250 * time.Millisecond
+)
+
+// createWithBlobStoreRetry wraps the create function of a repository. A blob
+// store created in the same apply may not be known to every node of a Nexus
+// cluster yet, so a create which fails because the blob store is not found
+// is retried with exponential backoff up to the retries of the provider.
+func createWithBlobStoreRetry(create schema.CreateFunc) schema.CreateFunc {
+	return func(resourceData *schema.ResourceData, m interface{}) error {
+		ctx := api.Context(m.(*api.Client))
+		retries := api.GetRetries(m.(*api.Client))
+		blobStoreName := resourceData.Get("storage.0.blob_store_name").(string)
+		backoff := blobStoreRetryInitialBackoff
+
+		for attempt := 0; ; attempt++ {
+			err := create(resourceData, m)
+			if !isBlobStoreNotFound(err, blobStoreName) || attempt == retries {
+				return err
+			}
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			backoff *= 2
+		}
+	}
+}
+
+func isBlobStoreNotFound(err error, blobStoreName string) bool {
+	if err == nil || blobStoreName == "" {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	return (strings.Contains(message, "blob store") || strings.Contains(message, "blobstore")) &&
+		(strings.Contains(message, "not found") || strings.Contains(message, "does not exist")) &&
+		strings.Contains(message, strings.ToLower(blobStoreName))
+}
@@ -31,7 +31,7 @@ func DataSourceRepositoryAptProxy() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"flat": {
-				Description: "Distribution to fetch",
+				Description: "Whether the remote is a flat repository without a dists/ directory",
 				Computed:    true,
 				Type:        schema.TypeBool,
 			},
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositoryBlobstore() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to get the blob store a repository of any format and type writes to.
+
+This allows to make e.g. a blob store quota or a blob store migration depend on the repositories stored in a blob store.`,
+
+		Read: dataSourceRepositoryBlobstoreRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"name": {
+				Description: "The name of the repository",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"format": {
+				Computed:    true,
+				Description: "The format of the repository, e.g. `maven2`",
+				Type:        schema.TypeString,
+			},
+			"type": {
+				Computed:    true,
+				Description: "The type of the repository. Possible values: `hosted`, `proxy` or `group`",
+				Type:        schema.TypeString,
+			},
+			"blob_store_name": {
+				Computed:    true,
+				Description: "The name of the blob store the repository stores its contents in",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceRepositoryBlobstoreRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	name := resourceData.Get("name").(string)
+
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return fmt.Errorf("reading repository %q: %w", name, err)
+	}
+	for _, repo := range repositories {
+		if repo.Name != name {
+			continue
+		}
+
+		storage, err := api.NewRepositoryStorageService(client).Get(repo.Format, repo.Type, repo.Name)
+		if err != nil {
+			return fmt.Errorf("reading repository %q: %w", name, err)
+		}
+		if storage == nil {
+			return fmt.Errorf("reading repository %q: repository not found", name)
+		}
+
+		resourceData.SetId(repo.Name)
+		resourceData.Set("format", repo.Format)
+		resourceData.Set("type", repo.Type)
+		resourceData.Set("blob_store_name", storage.BlobStoreName)
+		return nil
+	}
+
+	return fmt.Errorf("reading repository %q: repository not found", name)
+}
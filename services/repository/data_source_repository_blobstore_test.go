@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceRepositoryBlobstore(t *testing.T) {
+	dataSourceName := "data.nexus_repository_blobstore.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Nexus creates maven-releases in the default blob store on installation
+				Config: `
+data "nexus_repository_blobstore" "acceptance" {
+	name = "maven-releases"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "maven-releases"),
+					resource.TestCheckResourceAttr(dataSourceName, "format", "maven2"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "hosted"),
+					resource.TestCheckResourceAttr(dataSourceName, "blob_store_name", "default"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceRepositoryBlobstore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"maven-releases","format":"maven2","type":"hosted"},{"name":"npm-proxy","format":"npm","type":"proxy"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/maven/hosted/maven-releases":
+			fmt.Fprint(w, `{"name":"maven-releases","online":true,"storage":{"blobStoreName":"default","strictContentTypeValidation":true,"writePolicy":"ALLOW_ONCE"},"maven":{"versionPolicy":"RELEASE"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/npm/proxy/npm-proxy":
+			fmt.Fprint(w, `{"name":"npm-proxy","online":true,"storage":{"blobStoreName":"npm","strictContentTypeValidation":true}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	dataSource := acceptance.TestAccProvider.DataSourcesMap["nexus_repository_blobstore"]
+	read := func(name string) (*schema.ResourceData, error) {
+		resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": name})
+		return resourceData, dataSource.Read(resourceData, nexusClient)
+	}
+
+	resourceData, err := read("maven-releases")
+	assert.NoError(t, err)
+	assert.Equal(t, "maven-releases", resourceData.Id())
+	assert.Equal(t, "maven2", resourceData.Get("format"))
+	assert.Equal(t, "hosted", resourceData.Get("type"))
+	assert.Equal(t, "default", resourceData.Get("blob_store_name"))
+
+	resourceData, err = read("npm-proxy")
+	assert.NoError(t, err)
+	assert.Equal(t, "npm", resourceData.Get("blob_store_name"))
+
+	_, err = read("missing")
+	assert.EqualError(t, err, `reading repository "missing": repository not found`)
+}
@@ -8,7 +8,7 @@ import (
 
 func DataSourceRepositoryDockerGroup() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this data source to get an existing docker repository.",
+		Description: "Use this data source to get an existing docker group repository.",
 
 		Read: dataSourceRepositoryDockerGroupRead,
 		Schema: map[string]*schema.Schema{
@@ -20,7 +20,8 @@ func DataSourceRepositoryDockerGroup() *schema.Resource {
 			"group":   repository.DataSourceGroupDeploy,
 			"storage": repository.DataSourceStorage,
 			// Docker hosted schemas
-			"docker": repository.DataSourceDocker,
+			"docker":               repository.DataSourceDocker,
+			"docker_connector_url": repository.DataSourceDockerConnectorURL,
 		},
 	}
 }
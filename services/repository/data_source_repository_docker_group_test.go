@@ -1,14 +1,25 @@
 package repository_test
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccDataSourceRepositoryDockerGroupConfig() string {
@@ -29,6 +40,7 @@ func TestAccDataSourceRepositoryDockerGroup(t *testing.T) {
 		},
 		Docker: repository.Docker{
 			ForceBasicAuth: true,
+			HTTPPort:       tools.GetIntPointer(rand.Intn(999) + 34000),
 			V1Enabled:      true,
 		},
 		Group: repository.GroupDeploy{
@@ -53,7 +65,9 @@ func TestAccDataSourceRepositoryDockerGroup(t *testing.T) {
 						resource.ComposeAggregateTestCheckFunc(
 							resource.TestCheckResourceAttr(dataSourceName, "docker.#", "1"),
 							resource.TestCheckResourceAttr(dataSourceName, "docker.0.force_basic_auth", strconv.FormatBool(repoGroup.Docker.ForceBasicAuth)),
+							resource.TestCheckResourceAttr(dataSourceName, "docker.0.http_port", strconv.Itoa(*repoGroup.Docker.HTTPPort)),
 							resource.TestCheckResourceAttr(dataSourceName, "docker.0.v1_enabled", strconv.FormatBool(repoGroup.Docker.V1Enabled)),
+							resource.TestMatchResourceAttr(dataSourceName, "docker_connector_url", regexp.MustCompile(fmt.Sprintf(`^[^:/]+:%d$`, *repoGroup.Docker.HTTPPort))),
 						),
 						resource.ComposeAggregateTestCheckFunc(
 							resource.TestCheckResourceAttr(dataSourceName, "storage.#", "1"),
@@ -62,6 +76,7 @@ func TestAccDataSourceRepositoryDockerGroup(t *testing.T) {
 							resource.TestCheckResourceAttr(dataSourceName, "group.#", "1"),
 							resource.TestCheckResourceAttr(dataSourceName, "group.0.member_names.#", "1"),
 							resource.TestCheckResourceAttr(dataSourceName, "group.0.member_names.0", repoGroup.Group.MemberNames[0]),
+							resource.TestCheckResourceAttr(dataSourceName, "group.0.writable_member", ""),
 						),
 					),
 				),
@@ -69,3 +84,43 @@ func TestAccDataSourceRepositoryDockerGroup(t *testing.T) {
 		},
 	})
 }
+
+func TestDataSourceRepositoryDockerGroupConnectorURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/group/docker-public":
+			fmt.Fprint(w, `{"name":"docker-public","online":true,"storage":{"blobStoreName":"default","strictContentTypeValidation":true},"group":{"memberNames":["docker-hosted"]},"docker":{"v1Enabled":false,"forceBasicAuth":true,"httpPort":8085}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/group/docker-internal":
+			fmt.Fprint(w, `{"name":"docker-internal","online":true,"storage":{"blobStoreName":"default","strictContentTypeValidation":true},"group":{"memberNames":["docker-hosted"]},"docker":{"v1Enabled":false,"forceBasicAuth":true}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+
+	dataSource := acceptance.TestAccProvider.DataSourcesMap["nexus_repository_docker_group"]
+	resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": "docker-public"})
+	assert.NoError(t, dataSource.Read(resourceData, nexusClient))
+	assert.Equal(t, serverURL.Hostname()+":8085", resourceData.Get("docker_connector_url"))
+
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_group"]
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	resourceData.SetId("docker-public")
+	diags := res.ReadContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, serverURL.Hostname()+":8085", resourceData.Get("docker_connector_url"))
+
+	// Without a connector there is no address to report
+	resourceData.SetId("docker-internal")
+	diags = res.ReadContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "", resourceData.Get("docker_connector_url"))
+}
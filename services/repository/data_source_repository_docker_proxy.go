@@ -1,8 +1,12 @@
 package repository
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,13 +29,26 @@ func DataSourceRepositoryDockerProxy() *schema.Resource {
 			"routing_rule":   repositorySchema.DataSourceRoutingRule,
 			"storage":        repositorySchema.DataSourceStorage,
 			// Docker proxy schemas
-			"docker": repositorySchema.DataSourceDocker,
+			"docker": repositorySchema.DataSourceDockerWithSubdomain,
 			"docker_proxy": {
 				Description: "docker_proxy contains the configuration of the docker index",
 				Type:        schema.TypeList,
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"cache_foreign_layers": {
+							Description: "Allow Nexus Repository Manager to download and cache foreign layers",
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						"foreign_layer_url_whitelist": {
+							Description: "Regular expressions used to identify URLs that are allowed for foreign layer requests",
+							Computed:    true,
+							Type:        schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						"index_type": {
 							Description: "Type of Docker Index",
 							Computed:    true,
@@ -50,7 +67,19 @@ func DataSourceRepositoryDockerProxy() *schema.Resource {
 }
 
 func dataSourceRepositoryDockerProxyRead(resourceData *schema.ResourceData, m interface{}) error {
-	resourceData.SetId(resourceData.Get("name").(string))
+	client := m.(*api.Client)
+	name := resourceData.Get("name").(string)
 
-	return resourceDockerProxyRepositoryRead(resourceData, m)
+	if err := checkRepositoryFormat(client, name, repository.RepositoryFormatDocker, repository.RepositoryTypeProxy); err != nil {
+		return fmt.Errorf("reading docker proxy repository %q: %w", name, err)
+	}
+
+	resourceData.SetId(name)
+	if err := resourceDockerProxyRepositoryRead(resourceData, m); err != nil {
+		return err
+	}
+	if resourceData.Id() == "" {
+		return fmt.Errorf("reading docker proxy repository %q: repository not found", name)
+	}
+	return nil
 }
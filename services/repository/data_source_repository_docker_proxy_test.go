@@ -2,13 +2,19 @@ package repository_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccDataSourceRepositoryDockerProxyConfig() string {
@@ -19,16 +25,10 @@ data "nexus_repository_docker_proxy" "acceptance" {
 }
 
 func TestAccDataSourceRepositoryDockerProxy(t *testing.T) {
-	repoUsingDefaults := repository.DockerProxyRepository{
+	repoUsingDefaults := api.DockerProxyRepository{}
+	repoUsingDefaults.DockerProxyRepository = repository.DockerProxyRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
-		Docker: repository.Docker{
-			ForceBasicAuth: true,
-			V1Enabled:      true,
-		},
-		DockerProxy: repository.DockerProxy{
-			IndexType: repository.DockerProxyIndexTypeHub,
-		},
 		Proxy: repository.Proxy{
 			RemoteURL: "https://registry-1.docker.io",
 		},
@@ -37,6 +37,13 @@ func TestAccDataSourceRepositoryDockerProxy(t *testing.T) {
 			StrictContentTypeValidation: true,
 		},
 	}
+	repoUsingDefaults.Docker = api.Docker{
+		Docker: repository.Docker{
+			ForceBasicAuth: true,
+			V1Enabled:      true,
+		},
+	}
+	repoUsingDefaults.DockerProxy.IndexType = repository.DockerProxyIndexTypeHub
 
 	dataSourceName := "data.nexus_repository_docker_proxy.acceptance"
 
@@ -75,3 +82,39 @@ func TestAccDataSourceRepositoryDockerProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestDataSourceRepositoryDockerProxyForeignLayers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"docker-hub","format":"docker","type":"proxy"},{"name":"docker-releases","format":"docker","type":"hosted"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/proxy/docker-hub":
+			fmt.Fprint(w, `{"name":"docker-hub","online":true,"storage":{"blobStoreName":"default"},"proxy":{"remoteUrl":"https://registry-1.docker.io"},"negativeCache":{},"httpClient":{},"docker":{"v1Enabled":false},"dockerProxy":{"indexType":"HUB","cacheForeignLayers":true,"foreignLayerUrlWhitelist":[".*"]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	dataSource := acceptance.TestAccProvider.DataSourcesMap["nexus_repository_docker_proxy"]
+	read := func(name string) (*schema.ResourceData, error) {
+		resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": name})
+		return resourceData, dataSource.Read(resourceData, nexusClient)
+	}
+
+	resourceData, err := read("docker-hub")
+	assert.NoError(t, err)
+	assert.Equal(t, true, resourceData.Get("docker_proxy.0.cache_foreign_layers"))
+	assert.Equal(t, []interface{}{".*"}, resourceData.Get("docker_proxy.0.foreign_layer_url_whitelist"))
+	assert.Equal(t, "HUB", resourceData.Get("docker_proxy.0.index_type"))
+
+	_, err = read("docker-releases")
+	assert.EqualError(t, err, `reading docker proxy repository "docker-releases": repository is a docker hosted repository, expected a docker proxy repository`)
+	_, err = read("missing")
+	assert.EqualError(t, err, `reading docker proxy repository "missing": repository not found`)
+}
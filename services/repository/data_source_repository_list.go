@@ -1,8 +1,8 @@
 package repository
 
 import (
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,7 +47,7 @@ func DataSourceRepositoryList() *schema.Resource {
 }
 
 func dataSourceRepositoryList(dataSource *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	items := []map[string]string{}
 	repositories, err := client.Repository.List()
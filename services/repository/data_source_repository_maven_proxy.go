@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositoryMavenProxy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get an existing maven proxy repository, e.g. the `maven-central` repository Nexus creates on installation.",
+
+		Read: dataSourceRepositoryMavenProxyRead,
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.DataSourceID,
+			"name":   repositorySchema.DataSourceName,
+			"online": repositorySchema.DataSourceOnline,
+			// Proxy schemas
+			"cleanup":        repositorySchema.DataSourceCleanup,
+			"http_client":    repositorySchema.DataSourceHTTPClientWithPreemptiveAuth,
+			"negative_cache": repositorySchema.DataSourceNegativeCache,
+			"proxy":          repositorySchema.DataSourceProxy,
+			"routing_rule":   repositorySchema.DataSourceRoutingRule,
+			"storage":        repositorySchema.DataSourceStorage,
+			// Maven proxy schemas
+			"maven": repositorySchema.DataSourceMaven,
+		},
+	}
+}
+
+func dataSourceRepositoryMavenProxyRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	name := resourceData.Get("name").(string)
+
+	// Refuse e.g. the maven-public group instead of reading it half-way
+	if err := checkRepositoryFormat(client, name, repository.RepositoryFormatMaven2, repository.RepositoryTypeProxy); err != nil {
+		return fmt.Errorf("reading maven proxy repository %q: %w", name, err)
+	}
+
+	resourceData.SetId(name)
+	if err := resourceMavenProxyRepositoryRead(resourceData, m); err != nil {
+		return err
+	}
+	if resourceData.Id() == "" {
+		return fmt.Errorf("reading maven proxy repository %q: repository not found", name)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package repository_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccDataSourceRepositoryMavenProxyConfig() string {
+	return `
+data "nexus_repository_maven_proxy" "acceptance" {
+	name   = nexus_repository_maven_proxy.acceptance.id
+}`
+}
+
+func TestAccDataSourceRepositoryMavenProxy(t *testing.T) {
+	repo := testAccResourceRepositoryMavenProxy()
+	dataSourceName := "data.nexus_repository_maven_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryMavenProxyConfig(repo) + testAccDataSourceRepositoryMavenProxyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(dataSourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(dataSourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(dataSourceName, "http_client.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "maven.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "maven.0.version_policy", string(*repo.Maven.VersionPolicy)),
+						resource.TestCheckResourceAttr(dataSourceName, "maven.0.layout_policy", string(*repo.Maven.LayoutPolicy)),
+						resource.TestCheckResourceAttr(dataSourceName, "proxy.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "proxy.0.remote_url", repo.Proxy.RemoteURL),
+						resource.TestCheckResourceAttr(dataSourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceRepositoryMavenProxy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"maven-central","format":"maven2","type":"proxy"},{"name":"maven-public","format":"maven2","type":"group"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/maven/proxy/maven-central":
+			fmt.Fprint(w, `{"name":"maven-central","online":true,"storage":{"blobStoreName":"default"},"proxy":{"remoteUrl":"https://repo1.maven.org/maven2/"},"negativeCache":{},"httpClient":{"autoBlock":true},"maven":{"versionPolicy":"RELEASE","layoutPolicy":"PERMISSIVE"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	dataSource := acceptance.TestAccProvider.DataSourcesMap["nexus_repository_maven_proxy"]
+	read := func(name string) (*schema.ResourceData, error) {
+		resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": name})
+		return resourceData, dataSource.Read(resourceData, nexusClient)
+	}
+
+	resourceData, err := read("maven-central")
+	assert.NoError(t, err)
+	assert.Equal(t, "maven-central", resourceData.Id())
+	assert.Equal(t, "https://repo1.maven.org/maven2/", resourceData.Get("proxy.0.remote_url"))
+	assert.Equal(t, string(repository.MavenVersionPolicyRelease), resourceData.Get("maven.0.version_policy"))
+	assert.Equal(t, true, resourceData.Get("http_client.0.auto_block"))
+
+	_, err = read("maven-public")
+	assert.EqualError(t, err, `reading maven proxy repository "maven-public": repository is a maven2 group repository, expected a maven2 proxy repository`)
+	_, err = read("missing")
+	assert.EqualError(t, err, `reading maven proxy repository "missing": repository not found`)
+}
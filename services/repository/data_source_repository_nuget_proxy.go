@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositoryNugetProxy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get an existing nuget proxy repository.",
+
+		Read: dataSourceRepositoryNugetProxyRead,
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.DataSourceID,
+			"name":   repositorySchema.DataSourceName,
+			"online": repositorySchema.DataSourceOnline,
+			// Proxy schemas
+			"cleanup":        repositorySchema.DataSourceCleanup,
+			"http_client":    repositorySchema.DataSourceHTTPClient,
+			"negative_cache": repositorySchema.DataSourceNegativeCache,
+			"proxy":          repositorySchema.DataSourceProxy,
+			"routing_rule":   repositorySchema.DataSourceRoutingRule,
+			"storage":        repositorySchema.DataSourceStorage,
+			// Nuget proxy schemas
+			"nuget": repositorySchema.DataSourceNuget,
+		},
+	}
+}
+
+// There is no nuget proxy resource besides the deprecated nexus_repository
+// yet, so the data source reads the repository itself
+func dataSourceRepositoryNugetProxyRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	name := resourceData.Get("name").(string)
+
+	if err := checkRepositoryFormat(client, name, repository.RepositoryFormatNuget, repository.RepositoryTypeProxy); err != nil {
+		return fmt.Errorf("reading nuget proxy repository %q: %w", name, err)
+	}
+
+	repo, err := client.Repository.Nuget.Proxy.Get(name)
+	if err != nil {
+		return fmt.Errorf("reading nuget proxy repository %q: %w", name, err)
+	}
+	if repo == nil {
+		return fmt.Errorf("reading nuget proxy repository %q: repository not found", name)
+	}
+
+	return setNugetProxyRepositoryToResourceData(repo, resourceData)
+}
+
+func setNugetProxyRepositoryToResourceData(repo *repository.NugetProxyRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if repo.RoutingRuleName != nil {
+		resourceData.Set("routing_rule", repo.RoutingRuleName)
+	} else if repo.RoutingRule != nil {
+		resourceData.Set("routing_rule", repo.RoutingRule)
+	}
+
+	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("http_client", flattenHTTPClient(&repo.HTTPClient, resourceData)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("negative_cache", flattenNegativeCache(&repo.NegativeCache)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("proxy", flattenProxy(&repo.Proxy)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("nuget", flattenNuget(&repo.NugetProxy)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
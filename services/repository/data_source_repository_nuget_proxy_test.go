@@ -0,0 +1,53 @@
+package repository_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceRepositoryNugetProxy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"nuget.org-proxy","format":"nuget","type":"proxy"},{"name":"nuget-hosted","format":"nuget","type":"hosted"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/nuget/proxy/nuget.org-proxy":
+			fmt.Fprint(w, `{"name":"nuget.org-proxy","online":true,"storage":{"blobStoreName":"default"},"proxy":{"remoteUrl":"https://api.nuget.org/v3/index.json"},"negativeCache":{"enabled":true,"timeToLive":1440},"httpClient":{"autoBlock":true},"nugetProxy":{"queryCacheItemMaxAge":3600,"nugetVersion":"V3"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	dataSource := acceptance.TestAccProvider.DataSourcesMap["nexus_repository_nuget_proxy"]
+	read := func(name string) (*schema.ResourceData, error) {
+		resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": name})
+		return resourceData, dataSource.Read(resourceData, nexusClient)
+	}
+
+	resourceData, err := read("nuget.org-proxy")
+	assert.NoError(t, err)
+	assert.Equal(t, "nuget.org-proxy", resourceData.Id())
+	assert.Equal(t, "V3", resourceData.Get("nuget.0.nuget_version"))
+	assert.Equal(t, 3600, resourceData.Get("nuget.0.query_cache_item_max_age"))
+	assert.Equal(t, "https://api.nuget.org/v3/index.json", resourceData.Get("proxy.0.remote_url"))
+	assert.Equal(t, 1440, resourceData.Get("negative_cache.0.ttl"))
+	assert.Equal(t, true, resourceData.Get("http_client.0.auto_block"))
+
+	_, err = read("nuget-hosted")
+	assert.EqualError(t, err, `reading nuget proxy repository "nuget-hosted": repository is a nuget hosted repository, expected a nuget proxy repository`)
+	_, err = read("missing")
+	assert.EqualError(t, err, `reading nuget proxy repository "missing": repository not found`)
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositoryStats() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to count the components and assets of a repository of any format and type, e.g. for capacity planning.
+
+Nexus has no endpoint for these numbers, so all components and assets are listed page by page. This takes a while for repositories with many components.`,
+
+		Read: dataSourceRepositoryStatsRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"repository": {
+				Description: "The name of the repository",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"component_count": {
+				Computed:    true,
+				Description: "The number of components in the repository",
+				Type:        schema.TypeInt,
+			},
+			"asset_count": {
+				Computed:    true,
+				Description: "The number of assets in the repository, including assets which do not belong to a component, e.g. metadata",
+				Type:        schema.TypeInt,
+			},
+		},
+	}
+}
+
+func dataSourceRepositoryStatsRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	name := resourceData.Get("repository").(string)
+	service := api.NewComponentService(client)
+
+	componentCount, err := service.Count(name)
+	if err != nil {
+		return fmt.Errorf("reading stats of repository %q: %w", name, err)
+	}
+	assetCount, err := service.CountAssets(name)
+	if err != nil {
+		return fmt.Errorf("reading stats of repository %q: %w", name, err)
+	}
+
+	resourceData.SetId(name)
+	resourceData.Set("component_count", componentCount)
+	resourceData.Set("asset_count", assetCount)
+	return nil
+}
@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceRepositoryStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case query.Get("repository") == "maven-empty":
+			fmt.Fprint(w, `{"items":[],"continuationToken":null}`)
+		case query.Get("repository") != "maven-releases":
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/service/rest/v1/components" && query.Get("continuationToken") == "":
+			fmt.Fprint(w, `{"items":[{"id":"1"},{"id":"2"}],"continuationToken":"page2"}`)
+		case r.URL.Path == "/service/rest/v1/components" && query.Get("continuationToken") == "page2":
+			fmt.Fprint(w, `{"items":[{"id":"3"}],"continuationToken":null}`)
+		case r.URL.Path == "/service/rest/v1/assets":
+			fmt.Fprint(w, `{"items":[{"id":"a"},{"id":"b"},{"id":"c"},{"id":"d"},{"id":"e"},{"id":"f"},{"id":"g"}],"continuationToken":null}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	dataSource := acceptance.TestAccProvider.DataSourcesMap["nexus_repository_stats"]
+	read := func(name string) (*schema.ResourceData, error) {
+		resourceData := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"repository": name})
+		return resourceData, dataSource.Read(resourceData, nexusClient)
+	}
+
+	resourceData, err := read("maven-releases")
+	assert.NoError(t, err)
+	assert.Equal(t, "maven-releases", resourceData.Id())
+	assert.Equal(t, 3, resourceData.Get("component_count"))
+	assert.Equal(t, 7, resourceData.Get("asset_count"))
+
+	resourceData, err = read("maven-empty")
+	assert.NoError(t, err)
+	assert.Equal(t, "maven-empty", resourceData.Id())
+	assert.Equal(t, 0, resourceData.Get("component_count"))
+	assert.Equal(t, 0, resourceData.Get("asset_count"))
+
+	_, err = read("missing")
+	assert.ErrorContains(t, err, `reading stats of repository "missing": could not list components of repository 'missing': HTTP: 404`)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deleteWithProtection wraps the delete function of a repository to refuse
+// the deletion if deletion_protection is set
+func deleteWithProtection(deleteFunc schema.DeleteFunc) schema.DeleteFunc {
+	return func(resourceData *schema.ResourceData, m interface{}) error {
+		if resourceData.Get("deletion_protection").(bool) {
+			return fmt.Errorf("repository %q is protected against deletion, set deletion_protection to false and apply before destroying it", resourceData.Id())
+		}
+
+		return deleteFunc(resourceData, m)
+	}
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const dockerTokenRealm = "DockerToken"
+
+// withDockerAnonymousPullWarnings wraps the create or update function of a
+// docker repository to warn if the repository allows anonymous pulls, but
+// the Docker Bearer Token realm, which anonymous pulls need, is inactive
+func withDockerAnonymousPullWarnings(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+		created := resourceData.Id() == ""
+		diags := fn(ctx, resourceData, m)
+		if diags.HasError() {
+			return diags
+		}
+		if created || resourceData.HasChange("docker.0.force_basic_auth") {
+			diags = append(diags, dockerAnonymousPullWarnings(resourceData, m)...)
+		}
+		return diags
+	}
+}
+
+func dockerAnonymousPullWarnings(resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if resourceData.Get("docker.0.force_basic_auth").(bool) {
+		return nil
+	}
+
+	activeRealms, err := m.(*api.Client).Security.Realm.ListActive()
+	if err != nil {
+		return nil
+	}
+	for _, realm := range activeRealms {
+		if realm == dockerTokenRealm {
+			return nil
+		}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("docker repository %q does not allow anonymous pulls yet", resourceData.Get("name").(string)),
+		Detail: fmt.Sprintf("docker.force_basic_auth is false, but anonymous pulls need the realm %q (Docker Bearer Token Realm) to be active as well. "+
+			"Activate it with the nexus_security_realms resource, and enable anonymous access with the nexus_security_anonymous resource.", dockerTokenRealm),
+	}}
+}
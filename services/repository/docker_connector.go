@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateDockerHTTPSCertificateAlias ensures that a certificate alias is only
+// configured for an HTTPS connector
+func validateDockerHTTPSCertificateAlias(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Get("docker.0.https_certificate_alias").(string) != "" && diff.Get("docker.0.https_port").(int) == 0 {
+		return fmt.Errorf("docker.0.https_certificate_alias requires docker.0.https_port")
+	}
+	return nil
+}
+
+// validateDockerSubdomain ensures that the Nexus server supports subdomain
+// connectors if docker.0.subdomain is set
+func validateDockerSubdomain(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if client, ok := m.(*api.Client); ok && diff.Get("docker.0.subdomain").(string) != "" {
+		return api.RequireServerVersion(client, "docker.0.subdomain", "3.38")
+	}
+	return nil
+}
+
+// validateDockerConnectors returns a CustomizeDiffFunc which requires one of
+// the given connector attributes, e.g. docker.0.http_port, to be set.
+// Otherwise docker clients cannot reach the repository. Unset ports are not
+// sent to Nexus, so no connector is opened for them.
+func validateDockerConnectors(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		for _, key := range keys {
+			if _, ok := diff.GetOk(key); ok || !diff.NewValueKnown(key) {
+				return nil
+			}
+		}
+		return fmt.Errorf("one of %s is required, otherwise docker clients cannot reach the repository", strings.Join(keys, ", "))
+	}
+}
+
+func getDockerHTTPSCertificateAlias(dockerConfig map[string]interface{}) *string {
+	if alias, ok := dockerConfig["https_certificate_alias"]; ok && alias.(string) != "" {
+		return tools.GetStringPointer(alias.(string))
+	}
+	return nil
+}
+
+func getDockerSubdomain(dockerConfig map[string]interface{}) *string {
+	if subdomain, ok := dockerConfig["subdomain"]; ok && subdomain.(string) != "" {
+		return tools.GetStringPointer(subdomain.(string))
+	}
+	return nil
+}
+
+// dockerConnectorURL returns the address docker clients use to reach a
+// docker repository, e.g. `nexus.example.com:8085`. A subdomain takes
+// precedence over the HTTPS port, which takes precedence over the HTTP port.
+// The host is the one of the Nexus URL the provider is configured with. The
+// address is empty if the repository has no connector.
+func dockerConnectorURL(client *api.Client, docker repository.Docker, subdomain *string) (string, error) {
+	baseURL, err := api.BaseURL(client)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case subdomain != nil && *subdomain != "":
+		return fmt.Sprintf("%s.%s", *subdomain, baseURL.Host), nil
+	case docker.HTTPSPort != nil:
+		return fmt.Sprintf("%s:%d", baseURL.Hostname(), *docker.HTTPSPort), nil
+	case docker.HTTPPort != nil:
+		return fmt.Sprintf("%s:%d", baseURL.Hostname(), *docker.HTTPPort), nil
+	}
+	return "", nil
+}
+
+// checkDockerConnectorPorts returns an error naming the docker repository
+// which already listens on the HTTP or HTTPS port of the given connector.
+// Nexus itself only fails with an opaque server error in that case.
+func checkDockerConnectorPorts(client *api.Client, name string, docker repository.Docker) error {
+	ports := map[int]string{}
+	if docker.HTTPPort != nil {
+		ports[*docker.HTTPPort] = "http_port"
+	}
+	if docker.HTTPSPort != nil {
+		ports[*docker.HTTPSPort] = "https_port"
+	}
+	if len(ports) == 0 {
+		return nil
+	}
+
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return err
+	}
+	for _, repo := range repositories {
+		if repo.Format != repository.RepositoryFormatDocker || repo.Name == name {
+			continue
+		}
+		existing, err := getDockerConnector(client, repo)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			continue
+		}
+		for _, port := range []*int{existing.HTTPPort, existing.HTTPSPort} {
+			if port == nil {
+				continue
+			}
+			if field, ok := ports[*port]; ok {
+				return fmt.Errorf("docker.0.%s %d is already used by docker %s repository %q", field, *port, repo.Type, repo.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// getDockerConnector returns the connector of an existing docker repository
+// or nil if it vanished in the meantime
+func getDockerConnector(client *api.Client, repo repository.RepositoryInfo) (*repository.Docker, error) {
+	switch repo.Type {
+	case repository.RepositoryTypeHosted:
+		hosted, err := client.Repository.Docker.Hosted.Get(repo.Name)
+		if err != nil || hosted == nil {
+			return nil, err
+		}
+		return &hosted.Docker, nil
+	case repository.RepositoryTypeProxy:
+		proxy, err := client.Repository.Docker.Proxy.Get(repo.Name)
+		if err != nil || proxy == nil {
+			return nil, err
+		}
+		return &proxy.Docker, nil
+	case repository.RepositoryTypeGroup:
+		group, err := client.Repository.Docker.Group.Get(repo.Name)
+		if err != nil || group == nil {
+			return nil, err
+		}
+		return &group.Docker, nil
+	}
+	return nil, nil
+}
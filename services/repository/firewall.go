@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// npmFirewallError explains a failed create or update of a npm proxy which
+// enables quarantine settings, as Nexus rejects these settings unless it is
+// licensed for Sonatype Nexus Firewall.
+func npmFirewallError(resourceData *schema.ResourceData, err error) error {
+	if !resourceData.Get("npm.0.remove_quarantined").(bool) && !resourceData.Get("npm.0.remove_non_cataloged").(bool) {
+		return err
+	}
+	return fmt.Errorf("%w (npm.remove_quarantined and npm.remove_non_cataloged require Sonatype Nexus Firewall, disable them if Nexus is not licensed for it)", err)
+}
+
+// proprietaryComponentsError explains a failed create or update of a hosted
+// repository which marks its components as proprietary, as Nexus rejects
+// this setting unless it is licensed for Sonatype Nexus Firewall.
+func proprietaryComponentsError(resourceData *schema.ResourceData, err error) error {
+	if !resourceData.Get("component.0.proprietary_components").(bool) {
+		return err
+	}
+	return fmt.Errorf("%w (component.proprietary_components requires Sonatype Nexus Firewall, disable it if Nexus is not licensed for it)", err)
+}
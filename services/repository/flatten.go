@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,9 +34,29 @@ func flattenDocker(docker *repository.Docker) []map[string]interface{} {
 	return []map[string]interface{}{data}
 }
 
-func flattenDockerProxy(dockerProxy *repository.DockerProxy) []map[string]interface{} {
+func flattenDockerWithSubdomain(docker *api.Docker) []map[string]interface{} {
+	data := flattenDocker(&docker.Docker)
+	if docker.Subdomain != nil {
+		data[0]["subdomain"] = *docker.Subdomain
+	}
+
+	return data
+}
+
+func flattenDockerWithCertificateAlias(docker *api.Docker) []map[string]interface{} {
+	data := flattenDockerWithSubdomain(docker)
+	if docker.HTTPSCertificateAlias != nil {
+		data[0]["https_certificate_alias"] = *docker.HTTPSCertificateAlias
+	}
+
+	return data
+}
+
+func flattenDockerProxy(dockerProxy *api.DockerProxy) []map[string]interface{} {
 	data := map[string]interface{}{
-		"index_type": string(dockerProxy.IndexType),
+		"cache_foreign_layers":        dockerProxy.CacheForeignLayers,
+		"foreign_layer_url_whitelist": tools.StringSliceToInterfaceSlice(dockerProxy.ForeignLayerURLWhitelist),
+		"index_type":                  string(dockerProxy.IndexType),
 	}
 
 	if dockerProxy.IndexURL != nil {
@@ -103,6 +124,43 @@ func flattenHTTPClientAuthentication(auth *repository.HTTPClientAuthentication,
 	}
 }
 
+func flattenHTTPClientWithPreemptiveAuth(httpClient *repository.HTTPClientWithPreemptiveAuth, d *schema.ResourceData) []map[string]interface{} {
+	if httpClient == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"authentication": flattenHTTPClientAuthenticationWithPreemptive(httpClient.Authentication, d),
+			"auto_block":     httpClient.AutoBlock,
+			"blocked":        httpClient.Blocked,
+			"connection":     flattenHTTPClientConnection(httpClient.Connection),
+		},
+	}
+}
+
+func flattenHTTPClientAuthenticationWithPreemptive(auth *repository.HTTPClientAuthenticationWithPreemptive, d *schema.ResourceData) []map[string]interface{} {
+	if auth == nil {
+		return nil
+	}
+	data := map[string]interface{}{
+		"type":     auth.Type,
+		"password": d.Get("http_client.0.authentication.0.password").(string),
+	}
+	if auth.NTLMDomain != nil {
+		data["ntlm_domain"] = *auth.NTLMDomain
+	}
+	if auth.NTLMHost != nil {
+		data["ntlm_host"] = *auth.NTLMHost
+	}
+	if auth.Username != nil {
+		data["username"] = *auth.Username
+	}
+	if auth.Preemptive != nil {
+		data["preemptive"] = *auth.Preemptive
+	}
+	return []map[string]interface{}{data}
+}
+
 func flattenHTTPClientConnection(conn *repository.HTTPClientConnection) []map[string]interface{} {
 	if conn == nil {
 		return nil
@@ -179,6 +237,14 @@ func flattenHostedStorage(storage *repository.HostedStorage) []map[string]interf
 	return []map[string]interface{}{data}
 }
 
+func flattenDockerHostedStorage(storage *api.DockerHostedStorage) []map[string]interface{} {
+	data := flattenHostedStorage(&storage.HostedStorage)
+	if storage.LatestPolicy != nil {
+		data[0]["latest_policy"] = *storage.LatestPolicy
+	}
+	return data
+}
+
 func flattenMaven(maven *repository.Maven) []map[string]interface{} {
 	data := map[string]interface{}{}
 	if maven.VersionPolicy != nil {
@@ -193,3 +259,58 @@ func flattenMaven(maven *repository.Maven) []map[string]interface{} {
 
 	return []map[string]interface{}{data}
 }
+
+func flattenRaw(raw *repository.Raw) []map[string]interface{} {
+	if raw == nil {
+		return nil
+	}
+	data := map[string]interface{}{}
+	if raw.ContentDisposition != nil {
+		data["content_disposition"] = string(*raw.ContentDisposition)
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func flattenNpm(npm *repository.Npm) []map[string]interface{} {
+	if npm == nil {
+		return nil
+	}
+	data := map[string]interface{}{
+		"remove_non_cataloged": npm.RemoveNonCataloged,
+		"remove_quarantined":   npm.RemoveQuarantined,
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenNuget(nuget *repository.NugetProxy) []map[string]interface{} {
+	if nuget == nil {
+		return nil
+	}
+	data := map[string]interface{}{
+		"nuget_version":            nuget.NugetVersion,
+		"query_cache_item_max_age": nuget.QueryCacheItemMaxAge,
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenYumSigning(yumSigning *repository.YumSigning, d *schema.ResourceData) []map[string]interface{} {
+	if yumSigning == nil {
+		return nil
+	}
+	// Nexus may omit the secrets in its response, keep the configured values in that case
+	keypair := d.Get("yum_signing.0.keypair").(string)
+	if yumSigning.Keypair != nil && *yumSigning.Keypair != "" {
+		keypair = *yumSigning.Keypair
+	}
+	passphrase := d.Get("yum_signing.0.passphrase").(string)
+	if yumSigning.Passphrase != nil && *yumSigning.Passphrase != "" {
+		passphrase = *yumSigning.Passphrase
+	}
+	return []map[string]interface{}{
+		{
+			"keypair":    keypair,
+			"passphrase": passphrase,
+		},
+	}
+}
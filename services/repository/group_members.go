@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateGroupMemberNames rejects a group repository at plan time which
+// lists itself as a member, instead of failing with a server error on apply
+func validateGroupMemberNames(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("name") || !diff.NewValueKnown("group.0.member_names") {
+		return nil
+	}
+	name := diff.Get("name").(string)
+	memberNames, ok := diff.Get("group.0.member_names").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, memberName := range memberNames {
+		if member, ok := memberName.(string); ok && strings.EqualFold(member, name) {
+			return fmt.Errorf("group.0.member_names must not contain the group repository %q itself", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package repository_test
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testAccCheckRepositoryFormatAndType verifies format and type of a repository as reported by Nexus
+func testAccCheckRepositoryFormatAndType(name string, format string, repoType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*api.Client)
+		repos, err := client.Repository.List()
+		if err != nil {
+			return err
+		}
+		for _, repo := range repos {
+			if repo.Name != name {
+				continue
+			}
+			if repo.Format != format || repo.Type != repoType {
+				return fmt.Errorf("expected repository %s to be %s/%s, got %s/%s", name, format, repoType, repo.Format, repo.Type)
+			}
+			return nil
+		}
+		return fmt.Errorf("repository %s not found", name)
+	}
+}
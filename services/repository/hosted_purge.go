@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deleteWithComponentPurge wraps the delete function of a hosted repository
+// to delete all of its components first if purge_on_destroy is set
+func deleteWithComponentPurge(deleteFunc schema.DeleteFunc) schema.DeleteFunc {
+	return func(resourceData *schema.ResourceData, m interface{}) error {
+		if resourceData.Get("purge_on_destroy").(bool) {
+			if err := purgeRepositoryComponents(resourceData.Id(), m); err != nil {
+				return fmt.Errorf("purging components of repository %q: %w", resourceData.Id(), err)
+			}
+		}
+
+		return deleteFunc(resourceData, m)
+	}
+}
+
+func purgeRepositoryComponents(repoName string, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewComponentService(client)
+
+	// Collect all components first, deleting while paging may skip components
+	components, err := service.List(repoName)
+	if err != nil {
+		return err
+	}
+
+	for _, component := range components {
+		if err := service.Delete(component.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
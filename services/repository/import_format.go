@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importRepositoryOfFormat returns an importer which refuses to import a
+// repository of another format or type than the resource manages. Otherwise
+// e.g. a npm repository would be read into a maven resource and the next
+// apply would fail with a confusing server error.
+func importRepositoryOfFormat(format string, repositoryType string) schema.StateContextFunc {
+	return func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		client := m.(*api.Client)
+
+		if err := checkRepositoryFormat(client, resourceData.Id(), format, repositoryType); err != nil {
+			return nil, fmt.Errorf("importing repository %q: %w", resourceData.Id(), err)
+		}
+		return []*schema.ResourceData{resourceData}, nil
+	}
+}
+
+// checkRepositoryFormat returns an error if the repository does not exist or
+// is of another format or type than expected
+func checkRepositoryFormat(client *api.Client, name string, format string, repositoryType string) error {
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return err
+	}
+
+	for _, repo := range repositories {
+		if repo.Name != name {
+			continue
+		}
+		if repo.Format != format || repo.Type != repositoryType {
+			return fmt.Errorf("repository is a %s %s repository, expected a %s %s repository", repo.Format, repo.Type, format, repositoryType)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("repository not found")
+}
+
+// setRepositoryFormat sets the computed format and type of a typed repository
+// resource, so modules can reference them like those of the data sources
+func setRepositoryFormat(resourceData *schema.ResourceData, format string, repositoryType string) {
+	resourceData.Set("format", format)
+	resourceData.Set("type", repositoryType)
+}
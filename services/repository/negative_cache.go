@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultNegativeCacheTTL is the TTL of the negative_cache block in minutes
+// when it is left out
+const defaultNegativeCacheTTL = 1440
+
+// getNegativeCacheFromResourceData returns the negative cache configuration of
+// a proxy repository. The API requires one, so it falls back to the schema
+// defaults without a negative_cache block.
+func getNegativeCacheFromResourceData(resourceData *schema.ResourceData) repository.NegativeCache {
+	negativeCacheList := resourceData.Get("negative_cache").([]interface{})
+	if len(negativeCacheList) == 0 || negativeCacheList[0] == nil {
+		return repository.NegativeCache{
+			Enabled: false,
+			TTL:     defaultNegativeCacheTTL,
+		}
+	}
+
+	negativeCacheConfig := negativeCacheList[0].(map[string]interface{})
+	return repository.NegativeCache{
+		Enabled: negativeCacheConfig["enabled"].(bool),
+		TTL:     negativeCacheConfig["ttl"].(int),
+	}
+}
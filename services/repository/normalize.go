@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Nexus returns some optional blocks even when they only hold server
+// defaults. Writing those blocks to the state of an imported repository
+// would show up as a diff against a config which leaves them out, so they
+// are dropped as long as the state does not track the block yet.
+
+// normalizeCleanup drops a cleanup without any policy
+func normalizeCleanup(cleanup *repository.Cleanup, resourceData *schema.ResourceData) *repository.Cleanup {
+	if cleanup == nil || len(cleanup.PolicyNames) > 0 || hasBlock(resourceData, "cleanup") {
+		return cleanup
+	}
+	return nil
+}
+
+// normalizeNegativeCache drops a negative cache configured like the schema
+// defaults
+func normalizeNegativeCache(negativeCache *repository.NegativeCache, resourceData *schema.ResourceData) *repository.NegativeCache {
+	if negativeCache == nil || negativeCache.Enabled || negativeCache.TTL != defaultNegativeCacheTTL || hasBlock(resourceData, "negative_cache") {
+		return negativeCache
+	}
+	return nil
+}
+
+func hasBlock(resourceData *schema.ResourceData, key string) bool {
+	return len(resourceData.Get(key).([]interface{})) > 0
+}
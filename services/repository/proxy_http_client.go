@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// httpClientAuthenticationRequiredFields lists the fields of the HTTP client
+// authentication Nexus needs for each authentication type
+var httpClientAuthenticationRequiredFields = map[string][]string{
+	"ntlm":     {"ntlm_domain", "ntlm_host"},
+	"username": {"username", "password"},
+}
+
+// validateHTTPClientAuthentication rejects an HTTP client authentication at
+// plan time which lacks a field required by its type, instead of failing
+// with a server error on apply
+func validateHTTPClientAuthentication(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	authType, ok := diff.Get("http_client.0.authentication.0.type").(string)
+	if !ok || authType == "" {
+		return nil
+	}
+
+	for _, field := range httpClientAuthenticationRequiredFields[authType] {
+		key := fmt.Sprintf("http_client.0.authentication.0.%s", field)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		if diff.Get(key).(string) == "" {
+			return fmt.Errorf("%s is required when http_client.0.authentication.0.type is %q", key, authType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// createWithCachePriming wraps the create function of a proxy repository to
+// verify the remote and to fetch the prime_paths through the repository once
+// it has been created. As these are one-off side effects, neither
+// verify_remote_on_create nor prime_paths is compared to the server.
+func createWithCachePriming(create schema.CreateFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if diags := withContext(create)(ctx, resourceData, m); diags.HasError() {
+			return diags
+		}
+		if diags := verifyProxyRemote(resourceData, m); diags.HasError() {
+			return diags
+		}
+
+		return primeProxyRepositoryCache(resourceData, m)
+	}
+}
+
+func primeProxyRepositoryCache(resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client)
+
+	repoName := resourceData.Id()
+	severity := diag.Warning
+	if resourceData.Get("prime_strict").(bool) {
+		severity = diag.Error
+	}
+
+	var diags diag.Diagnostics
+	service := api.NewRepositoryContentService(client)
+	for _, path := range resourceData.Get("prime_paths").([]interface{}) {
+		if err := service.Fetch(repoName, path.(string)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  fmt.Sprintf("priming cache of repository %q with %q failed", repoName, path),
+				Detail:   err.Error(),
+			})
+		}
+	}
+	return diags
+}
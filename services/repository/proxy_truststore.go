@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// proxyTrustStoreWarnings warns about a proxy which uses the truststore of
+// Nexus, but the truststore contains neither the certificate of the remote
+// nor the certificate of its issuer. The check is best effort, it is skipped
+// if Nexus cannot retrieve the certificates.
+func proxyTrustStoreWarnings(resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !resourceData.Get("http_client.0.connection.0.use_trust_store").(bool) {
+		return nil
+	}
+	remoteURL := resourceData.Get("proxy.0.remote_url").(string)
+	host, port, ok := httpsRemoteHostPort(remoteURL)
+	if !ok {
+		return nil
+	}
+
+	service := api.NewSSLService(m.(*api.Client))
+	certificate, err := service.GetCertificate(host, port)
+	if err != nil {
+		return nil
+	}
+	trusted, err := service.ListTruststoreCertificates()
+	if err != nil {
+		return nil
+	}
+	for _, trustedCertificate := range trusted {
+		if strings.EqualFold(trustedCertificate.Fingerprint, certificate.Fingerprint) ||
+			(certificate.IssuerCommonName != "" && trustedCertificate.SubjectCommonName == certificate.IssuerCommonName) {
+			return nil
+		}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("truststore of Nexus has no certificate for the remote of proxy repository %q", resourceData.Get("name").(string)),
+		Detail: fmt.Sprintf("http_client.connection.use_trust_store is set, but the truststore contains neither the certificate for %s (fingerprint %s) nor the certificate of its issuer %s. "+
+			"Add one of them to the truststore of Nexus, otherwise Nexus cannot connect to %s.",
+			certificate.SubjectCommonName, certificate.Fingerprint, certificate.IssuerCommonName, remoteURL),
+	}}
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// verifyProxyRemote checks whether Nexus can reach the remote of a freshly
+// created proxy repository if verify_remote_on_create is set. Nexus creates
+// the repository regardless, so an unreachable remote only shows on the
+// first pull otherwise.
+func verifyProxyRemote(resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !resourceData.Get("verify_remote_on_create").(bool) {
+		return nil
+	}
+	client := m.(*api.Client)
+	repoName := resourceData.Id()
+	remoteURL := resourceData.Get("proxy.0.remote_url").(string)
+
+	err := api.NewRepositoryContentService(client).VerifyRemote(repoName)
+	if err == nil {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("remote %s of proxy repository %q is not reachable", remoteURL, repoName),
+		Detail:   fmt.Sprintf("%v\n\n%s", err, diagnoseRemoteConnection(client, remoteURL)),
+	}}
+}
+
+// diagnoseRemoteConnection tells why Nexus cannot reach an HTTPS remote by
+// letting Nexus retrieve its certificate
+func diagnoseRemoteConnection(client *api.Client, remoteURL string) string {
+	host, port, ok := httpsRemoteHostPort(remoteURL)
+	if !ok {
+		return "Check that Nexus can resolve and connect to the remote, e.g. through its HTTP proxy settings."
+	}
+
+	certificate, err := api.NewSSLService(client).GetCertificate(host, port)
+	if err != nil {
+		return fmt.Sprintf("Nexus cannot connect to %s:%d: %v", host, port, err)
+	}
+	return fmt.Sprintf("Nexus can connect to %s:%d, but probably does not trust its certificate for %s issued by %s (fingerprint %s). "+
+		"Add the certificate to the truststore of Nexus and set http_client.connection.use_trust_store.",
+		host, port, certificate.SubjectCommonName, certificate.IssuerCommonName, certificate.Fingerprint)
+}
+
+// httpsRemoteHostPort returns host and port of an HTTPS remote URL. ok is
+// false for other remotes.
+func httpsRemoteHostPort(remoteURL string) (host string, port int, ok bool) {
+	remote, err := url.Parse(remoteURL)
+	if err != nil || remote.Scheme != "https" {
+		return "", 0, false
+	}
+
+	port = 443
+	if remote.Port() != "" {
+		port, _ = strconv.Atoi(remote.Port())
+	}
+	return remote.Hostname(), port, true
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	readAfterCreateAttempts       = 4
+	readAfterCreateInitialBackoff = 250 * time.Millisecond
+)
+
+// readRepositoryAfterCreate reads a repository which has just been created.
+// On a Nexus cluster behind a load balancer the repository may not be
+// readable on every node yet, so a not found repository is read again a few
+// times with exponential backoff before giving up.
+func readRepositoryAfterCreate(resourceData *schema.ResourceData, m interface{}, read schema.ReadFunc) error {
+	ctx := api.Context(m.(*api.Client))
+	id := resourceData.Id()
+	backoff := readAfterCreateInitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := read(resourceData, m)
+		notFound := (err == nil && resourceData.Id() == "") || isRepositoryNotFound(err)
+		if !notFound || attempt == readAfterCreateAttempts {
+			return err
+		}
+
+		resourceData.SetId(id)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+func isRepositoryNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "HTTP: 404")
+}
@@ -1,11 +1,15 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,35 +17,44 @@ func ResourceRepositoryAptProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted apt repository.",
 
-		Create: resourceAptProxyRepositoryCreate,
-		Delete: resourceAptProxyRepositoryDelete,
-		Exists: resourceAptProxyRepositoryExists,
-		Read:   resourceAptProxyRepositoryRead,
-		Update: resourceAptProxyRepositoryUpdate,
+		CreateContext: resourceAptProxyRepositoryCreateContext,
+		DeleteContext: withContext(deleteWithProtection(resourceAptProxyRepositoryDelete)),
+		Exists:        resourceAptProxyRepositoryExists,
+		ReadContext:   withContext(resourceAptProxyRepositoryRead),
+		UpdateContext: resourceAptProxyRepositoryUpdateContext,
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: validateHTTPClientAuthentication,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatApt, repository.RepositoryTypeProxy),
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
 			// Proxy schemas
-			"cleanup":        repositorySchema.ResourceCleanup,
-			"http_client":    repositorySchema.ResourceHTTPClient,
-			"negative_cache": repositorySchema.ResourceNegativeCache,
-			"proxy":          repositorySchema.ResourceProxy,
-			"routing_rule":   repositorySchema.ResourceRoutingRule,
-			"storage":        repositorySchema.ResourceStorage,
+			"cleanup":                 repositorySchema.ResourceCleanup,
+			"http_client":             repositorySchema.ResourceHTTPClient,
+			"negative_cache":          repositorySchema.ResourceNegativeCache,
+			"prime_paths":             repositorySchema.ResourcePrimePaths,
+			"prime_strict":            repositorySchema.ResourcePrimeStrict,
+			"verify_remote_on_create": repositorySchema.ResourceVerifyRemoteOnCreate,
+			"proxy":                   repositorySchema.ResourceProxy,
+			"routing_rule":            repositorySchema.ResourceRoutingRule,
+			"storage":                 repositorySchema.ResourceStorage,
 			// Apt proxy schemas
 			"distribution": {
-				Description: "Distribution to fetch",
+				Description: "Distribution to fetch, e.g. `bookworm`. Set it to an empty string if `flat` is set, it is ignored then",
 				Required:    true,
 				Type:        schema.TypeString,
 			},
 			"flat": {
-				Description: "Distribution to fetch",
+				Description: "Whether the remote is a flat repository without a dists/ directory",
 				Required:    true,
 				Type:        schema.TypeBool,
 			},
@@ -49,9 +62,55 @@ func ResourceRepositoryAptProxy() *schema.Resource {
 	}
 }
 
+// aptProxyFlatWarnings warns about a distribution which does not fit flat.
+// Nexus fetches the metadata of a flat repository from the root of the
+// remote and ignores the distribution, otherwise it fetches it from
+// dists/<distribution>/. Either mistake is only noticed when the metadata
+// cannot be resolved.
+func aptProxyFlatWarnings(resourceData *schema.ResourceData) diag.Diagnostics {
+	name := resourceData.Get("name").(string)
+	distribution := resourceData.Get("distribution").(string)
+	flat := resourceData.Get("flat").(bool)
+
+	switch {
+	case !flat && distribution == "":
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("apt proxy repository %q has no distribution", name),
+			Detail:   "Nexus fetches the metadata of the remote from dists/<distribution>/. Set distribution, e.g. to bookworm, or set flat to true if the remote is a flat repository.",
+		}}
+	case flat && distribution != "":
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("apt proxy repository %q is flat, its distribution %q is ignored", name, distribution),
+			Detail:   "Nexus fetches the metadata of a flat repository from the root of the remote. Set flat to false if the remote has a dists/ directory, otherwise set distribution to an empty string.",
+		}}
+	}
+	return nil
+}
+
+func resourceAptProxyRepositoryCreateContext(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	warnings := aptProxyFlatWarnings(resourceData)
+
+	diags := createWithCachePriming(createWithAdoption(repository.RepositoryFormatApt, repository.RepositoryTypeProxy, createWithBlobStoreRetry(resourceAptProxyRepositoryCreate), resourceAptProxyRepositoryRead))(ctx, resourceData, m)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, warnings...)
+}
+
+func resourceAptProxyRepositoryUpdateContext(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	warnings := aptProxyFlatWarnings(resourceData)
+
+	diags := withContext(resourceAptProxyRepositoryUpdate)(ctx, resourceData, m)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, warnings...)
+}
+
 func getAptProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.AptProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
@@ -70,10 +129,7 @@ func getAptProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCacheFromResourceData(resourceData),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -153,7 +209,7 @@ func setAptProxyRepositoryToResourceData(repo *repository.AptProxyRepository, re
 		return err
 	}
 
-	if err := resourceData.Set("negative_cache", flattenNegativeCache(&repo.NegativeCache)); err != nil {
+	if err := resourceData.Set("negative_cache", flattenNegativeCache(normalizeNegativeCache(&repo.NegativeCache, resourceData))); err != nil {
 		return err
 	}
 
@@ -162,7 +218,7 @@ func setAptProxyRepositoryToResourceData(repo *repository.AptProxyRepository, re
 	}
 
 	if repo.Cleanup != nil {
-		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
 			return err
 		}
 	}
@@ -170,24 +226,24 @@ func setAptProxyRepositoryToResourceData(repo *repository.AptProxyRepository, re
 }
 
 func resourceAptProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getAptProxyRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Apt.Proxy.Create(repo); err != nil {
-		return err
+		return fmt.Errorf("creating apt proxy repository %q: %w", repo.Name, err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceAptProxyRepositoryRead(resourceData, m)
+	return readRepositoryAfterCreate(resourceData, m, resourceAptProxyRepositoryRead)
 }
 
 func resourceAptProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Apt.Proxy.Get(resourceData.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading apt proxy repository %q: %w", resourceData.Id(), err)
 	}
 
 	if repo == nil {
@@ -195,29 +251,34 @@ func resourceAptProxyRepositoryRead(resourceData *schema.ResourceData, m interfa
 		return nil
 	}
 
+	setRepositoryFormat(resourceData, repository.RepositoryFormatApt, repository.RepositoryTypeProxy)
 	return setAptProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceAptProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := resourceData.Id()
 	repo := getAptProxyRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Apt.Proxy.Update(repoName, repo); err != nil {
-		return err
+		return fmt.Errorf("updating apt proxy repository %q: %w", repoName, err)
 	}
 
 	return resourceAptProxyRepositoryRead(resourceData, m)
 }
 
 func resourceAptProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Apt.Proxy.Delete(resourceData.Id())
+	client := m.(*api.Client)
+
+	if err := client.Repository.Apt.Proxy.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting apt proxy repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
 }
 
 func resourceAptProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Apt.Proxy.Get(resourceData.Id())
 	return repo != nil, err
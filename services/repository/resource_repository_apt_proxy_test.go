@@ -2,16 +2,26 @@ package repository_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
-	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	nexusSchema "github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceRepositoryAptProxy() repository.AptProxyRepository {
@@ -74,10 +84,10 @@ func testAccResourceRepositoryAptProxyConfig(repo repository.AptProxyRepository)
 }
 
 func TestAccResourceRepositoryAptProxy(t *testing.T) {
-	routingRule := schema.RoutingRule{
+	routingRule := nexusSchema.RoutingRule{
 		Name:        acctest.RandString(10),
 		Description: "acceptance test",
-		Mode:        schema.RoutingRuleModeAllow,
+		Mode:        nexusSchema.RoutingRuleModeAllow,
 		Matchers: []string{
 			"/",
 		},
@@ -144,3 +154,59 @@ func TestAccResourceRepositoryAptProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceRepositoryAptProxyFlat(t *testing.T) {
+	var created json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/apt/proxy":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/apt/proxy/obs-tools":
+			w.Write(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_apt_proxy"]
+	config := func(distribution string, flat bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":           "obs-tools",
+			"online":         true,
+			"distribution":   distribution,
+			"flat":           flat,
+			"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+			"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://download.opensuse.org/repositories/tools/Debian_11/"}},
+			"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+			"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+		}
+	}
+
+	// A flat Debian mirror, as published by the Open Build Service
+	assert.Empty(t, res.Validate(terraform.NewResourceConfigRaw(config("", true))))
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config("", true))
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.Empty(t, diags)
+	assert.Equal(t, true, resourceData.Get("flat"))
+	assert.Equal(t, "", resourceData.Get("distribution"))
+
+	diags = res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, config("bullseye", true)), nexusClient)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, `apt proxy repository "obs-tools" is flat, its distribution "bullseye" is ignored`, diags[0].Summary)
+
+	diags = res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, config("", false)), nexusClient)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, `apt proxy repository "obs-tools" has no distribution`, diags[0].Summary)
+
+	diags = res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, config("bullseye", false)), nexusClient)
+	assert.Empty(t, diags)
+}
@@ -1,11 +1,14 @@
 package repository
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,51 +16,64 @@ func ResourceRepositoryDockerGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group docker repository.",
 
-		Create: resourceDockerGroupRepositoryCreate,
-		Delete: resourceDockerGroupRepositoryDelete,
-		Exists: resourceDockerGroupRepositoryExists,
-		Read:   resourceDockerGroupRepositoryRead,
-		Update: resourceDockerGroupRepositoryUpdate,
+		CreateContext: withDockerAnonymousPullWarnings(withContext(createWithAdoption(repository.RepositoryFormatDocker, repository.RepositoryTypeGroup, createWithBlobStoreRetry(resourceDockerGroupRepositoryCreate), resourceDockerGroupRepositoryRead))),
+		DeleteContext: withContext(deleteWithProtection(resourceDockerGroupRepositoryDelete)),
+		Exists:        resourceDockerGroupRepositoryExists,
+		ReadContext:   withContext(resourceDockerGroupRepositoryRead),
+		UpdateContext: withDockerAnonymousPullWarnings(withContext(resourceDockerGroupRepositoryUpdate)),
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: customdiff.Sequence(validateGroupMemberNames, validateDockerSubdomain, validateDockerConnectors("docker.0.http_port", "docker.0.https_port", "docker.0.subdomain")),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatDocker, repository.RepositoryTypeGroup),
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
 			// Group schemas
-			"group":   repositorySchema.ResourceGroupDeploy,
-			"storage": repositorySchema.ResourceStorage,
+			"group":                  repositorySchema.ResourceGroupDeploy,
+			"member_order_sensitive": repositorySchema.ResourceGroupMemberOrderSensitive,
+			"storage":                repositorySchema.ResourceStorage,
 			// Docker group schemas
-			"docker": repositorySchema.ResourceDocker,
+			"docker":               repositorySchema.ResourceDocker,
+			"docker_connector_url": repositorySchema.ResourceDockerConnectorURL,
 		},
 	}
 }
 
-func getDockerGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.DockerGroupRepository {
+func getDockerGroupRepositoryFromResourceData(resourceData *schema.ResourceData) api.DockerGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	dockerConfig := resourceData.Get("docker").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
-	repo := repository.DockerGroupRepository{
-		Name:   resourceData.Get("name").(string),
-		Online: resourceData.Get("online").(bool),
-		Storage: repository.Storage{
-			BlobStoreName:               storageConfig["blob_store_name"].(string),
-			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
-		},
-		Group: repository.GroupDeploy{
-			MemberNames: groupMemberNames,
+	repo := api.DockerGroupRepository{
+		DockerGroupRepository: repository.DockerGroupRepository{
+			Name:   resourceData.Get("name").(string),
+			Online: resourceData.Get("online").(bool),
+			Storage: repository.Storage{
+				BlobStoreName:               storageConfig["blob_store_name"].(string),
+				StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+			},
+			Group: repository.GroupDeploy{
+				MemberNames: groupMemberNames,
+			},
 		},
-		Docker: repository.Docker{
-			ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
-			V1Enabled:      dockerConfig["v1_enabled"].(bool),
+		Docker: api.Docker{
+			Docker: repository.Docker{
+				ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
+				V1Enabled:      dockerConfig["v1_enabled"].(bool),
+			},
+			Subdomain: getDockerSubdomain(dockerConfig),
 		},
 	}
 
@@ -80,12 +96,12 @@ func getDockerGroupRepositoryFromResourceData(resourceData *schema.ResourceData)
 	return repo
 }
 
-func setDockerGroupRepositoryToResourceData(repo *repository.DockerGroupRepository, resourceData *schema.ResourceData) error {
+func setDockerGroupRepositoryToResourceData(repo *api.DockerGroupRepository, resourceData *schema.ResourceData, client *api.Client) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
 
-	if err := resourceData.Set("docker", flattenDocker(&repo.Docker)); err != nil {
+	if err := resourceData.Set("docker", flattenDockerWithSubdomain(&repo.Docker)); err != nil {
 		return err
 	}
 
@@ -97,28 +113,39 @@ func setDockerGroupRepositoryToResourceData(repo *repository.DockerGroupReposito
 		return err
 	}
 
+	connectorURL, err := dockerConnectorURL(client, repo.Docker.Docker, repo.Docker.Subdomain)
+	if err != nil {
+		return err
+	}
+	if err := resourceData.Set("docker_connector_url", connectorURL); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceDockerGroupRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getDockerGroupRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Docker.Group.Create(repo); err != nil {
-		return err
+	if err := checkDockerConnectorPorts(client, repo.Name, repo.Docker.Docker); err != nil {
+		return fmt.Errorf("creating docker group repository %q: %w", repo.Name, err)
+	}
+	if err := api.NewRepositoryDockerGroupService(client).Create(repo); err != nil {
+		return fmt.Errorf("creating docker group repository %q: %w", repo.Name, err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceDockerGroupRepositoryRead(resourceData, m)
+	return readRepositoryAfterCreate(resourceData, m, resourceDockerGroupRepositoryRead)
 }
 
 func resourceDockerGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
-	repo, err := client.Repository.Docker.Group.Get(resourceData.Id())
+	repo, err := api.NewRepositoryDockerGroupService(client).Get(resourceData.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading docker group repository %q: %w", resourceData.Id(), err)
 	}
 
 	if repo == nil {
@@ -126,29 +153,34 @@ func resourceDockerGroupRepositoryRead(resourceData *schema.ResourceData, m inte
 		return nil
 	}
 
-	return setDockerGroupRepositoryToResourceData(repo, resourceData)
+	setRepositoryFormat(resourceData, repository.RepositoryFormatDocker, repository.RepositoryTypeGroup)
+	return setDockerGroupRepositoryToResourceData(repo, resourceData, client)
 }
 
 func resourceDockerGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := resourceData.Id()
 	repo := getDockerGroupRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Docker.Group.Update(repoName, repo); err != nil {
-		return err
+	if err := api.NewRepositoryDockerGroupService(client).Update(repoName, repo); err != nil {
+		return fmt.Errorf("updating docker group repository %q: %w", repoName, err)
 	}
 
 	return resourceDockerGroupRepositoryRead(resourceData, m)
 }
 
 func resourceDockerGroupRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Docker.Group.Delete(resourceData.Id())
+	client := m.(*api.Client)
+
+	if err := client.Repository.Docker.Group.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting docker group repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
 }
 
 func resourceDockerGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Docker.Group.Get(resourceData.Id())
 	return repo != nil, err
@@ -2,17 +2,27 @@ package repository_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceRepositoryDockerGroup() repository.DockerGroupRepository {
@@ -95,3 +105,71 @@ func TestAccResourceRepositoryDockerGroup(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceRepositoryDockerGroupSelfMember(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_group"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "docker-public",
+		"online":  true,
+		"docker":  []interface{}{map[string]interface{}{"force_basic_auth": true, "v1_enabled": false}},
+		"group":   []interface{}{map[string]interface{}{"member_names": []interface{}{"docker-public"}}},
+		"storage": []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+	})
+
+	_, err := res.Diff(context.Background(), nil, config, nil)
+	assert.EqualError(t, err, `group.0.member_names must not contain the group repository "docker-public" itself`)
+}
+
+func TestResourceRepositoryDockerGroupSubdomain(t *testing.T) {
+	serverHeader := "Nexus/3.37.3-02 (OSS)"
+	var created json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/status":
+			w.Header().Set("Server", serverHeader)
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/docker/group":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/group/docker-public":
+			w.Write(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_group"]
+	config := map[string]interface{}{
+		"name":    "docker-public",
+		"online":  true,
+		"docker":  []interface{}{map[string]interface{}{"force_basic_auth": true, "v1_enabled": false, "subdomain": "docker-public"}},
+		"group":   []interface{}{map[string]interface{}{"member_names": []interface{}{"docker-hosted"}}},
+		"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+	}
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	_, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nexusClient)
+	assert.EqualError(t, err, "docker.0.subdomain requires Nexus >= 3.38, but Nexus 3.37.3-02 is used")
+
+	serverHeader = "Nexus/3.38.1-01 (OSS)"
+	nexusClient = api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	_, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nexusClient)
+	assert.NoError(t, err)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, string(created), `"subdomain":"docker-public"`)
+	assert.Equal(t, "docker-public", resourceData.Get("docker.0.subdomain"))
+	assert.Equal(t, "docker-public."+serverURL.Host, resourceData.Get("docker_connector_url"))
+}
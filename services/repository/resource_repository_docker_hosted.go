@@ -1,11 +1,15 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,46 +17,69 @@ func ResourceRepositoryDockerHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted docker repository.",
 
-		Create: resourceDockerHostedRepositoryCreate,
-		Delete: resourceDockerHostedRepositoryDelete,
-		Exists: resourceDockerHostedRepositoryExists,
-		Read:   resourceDockerHostedRepositoryRead,
-		Update: resourceDockerHostedRepositoryUpdate,
+		CreateContext: withDockerAnonymousPullWarnings(withContext(createWithAdoption(repository.RepositoryFormatDocker, repository.RepositoryTypeHosted, createWithBlobStoreRetry(resourceDockerHostedRepositoryCreate), resourceDockerHostedRepositoryRead))),
+		DeleteContext: withContext(deleteWithProtection(deleteWithComponentPurge(resourceDockerHostedRepositoryDelete))),
+		Exists:        resourceDockerHostedRepositoryExists,
+		ReadContext:   withContext(resourceDockerHostedRepositoryRead),
+		UpdateContext: withDockerAnonymousPullWarnings(withContext(resourceDockerHostedRepositoryUpdate)),
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: customdiff.Sequence(resourceDockerHostedRepositoryCustomizeDiff, validateDockerSubdomain, validateDockerHTTPSCertificateAlias, validateDockerConnectors("docker.0.http_port", "docker.0.https_port", "docker.0.subdomain")),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatDocker, repository.RepositoryTypeHosted),
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
+			"purge_on_destroy":    repositorySchema.ResourcePurgeOnDestroy,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
-			"storage":   repositorySchema.ResourceHostedStorage,
+			"storage":   repositorySchema.ResourceDockerHostedStorage,
 			// Docker hosted schemas
-			"docker": repositorySchema.ResourceDocker,
+			"docker": repositorySchema.ResourceDockerWithSubdomain,
 		},
 	}
 }
 
-func getDockerHostedRepositoryFromResourceData(resourceData *schema.ResourceData) repository.DockerHostedRepository {
+func resourceDockerHostedRepositoryCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Get("storage.0.latest_policy").(bool) && diff.Get("storage.0.write_policy").(string) != string(repository.StorageWritePolicyAllowOnce) {
+		return fmt.Errorf("storage.0.latest_policy requires storage.0.write_policy to be %q", repository.StorageWritePolicyAllowOnce)
+	}
+	return nil
+}
+
+func getDockerHostedRepositoryFromResourceData(resourceData *schema.ResourceData) api.DockerHostedRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	writePolicy := repository.StorageWritePolicy(storageConfig["write_policy"].(string))
 	dockerConfig := resourceData.Get("docker").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.DockerHostedRepository{
-		Name:   resourceData.Get("name").(string),
-		Online: resourceData.Get("online").(bool),
-		Storage: repository.HostedStorage{
-			BlobStoreName:               storageConfig["blob_store_name"].(string),
-			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
-			WritePolicy:                 &writePolicy,
+	repo := api.DockerHostedRepository{
+		DockerHostedRepository: repository.DockerHostedRepository{
+			Name:   resourceData.Get("name").(string),
+			Online: resourceData.Get("online").(bool),
+		},
+		Docker: api.Docker{
+			Docker: repository.Docker{
+				ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
+				V1Enabled:      dockerConfig["v1_enabled"].(bool),
+			},
+			HTTPSCertificateAlias: getDockerHTTPSCertificateAlias(dockerConfig),
+			Subdomain:             getDockerSubdomain(dockerConfig),
 		},
-		Docker: repository.Docker{
-			ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
-			V1Enabled:      dockerConfig["v1_enabled"].(bool),
+		Storage: api.DockerHostedStorage{
+			HostedStorage: repository.HostedStorage{
+				BlobStoreName:               storageConfig["blob_store_name"].(string),
+				StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+				WritePolicy:                 &writePolicy,
+			},
+			LatestPolicy: tools.GetBoolPointer(storageConfig["latest_policy"].(bool)),
 		},
 	}
 
@@ -94,21 +121,21 @@ func getDockerHostedRepositoryFromResourceData(resourceData *schema.ResourceData
 	return repo
 }
 
-func setDockerHostedRepositoryToResourceData(repo *repository.DockerHostedRepository, resourceData *schema.ResourceData) error {
+func setDockerHostedRepositoryToResourceData(repo *api.DockerHostedRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
 
-	if err := resourceData.Set("docker", flattenDocker(&repo.Docker)); err != nil {
+	if err := resourceData.Set("docker", flattenDockerWithCertificateAlias(&repo.Docker)); err != nil {
 		return err
 	}
 
-	if err := resourceData.Set("storage", flattenHostedStorage(&repo.Storage)); err != nil {
+	if err := resourceData.Set("storage", flattenDockerHostedStorage(&repo.Storage)); err != nil {
 		return err
 	}
 
 	if repo.Cleanup != nil {
-		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
 			return err
 		}
 	}
@@ -123,24 +150,27 @@ func setDockerHostedRepositoryToResourceData(repo *repository.DockerHostedReposi
 }
 
 func resourceDockerHostedRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getDockerHostedRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Docker.Hosted.Create(repo); err != nil {
-		return err
+	if err := checkDockerConnectorPorts(client, repo.Name, repo.Docker.Docker); err != nil {
+		return fmt.Errorf("creating docker hosted repository %q: %w", repo.Name, err)
+	}
+	if err := api.NewRepositoryDockerHostedService(client).Create(repo); err != nil {
+		return fmt.Errorf("creating docker hosted repository %q: %w", repo.Name, err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceDockerHostedRepositoryRead(resourceData, m)
+	return readRepositoryAfterCreate(resourceData, m, resourceDockerHostedRepositoryRead)
 }
 
 func resourceDockerHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
-	repo, err := client.Repository.Docker.Hosted.Get(resourceData.Id())
+	repo, err := api.NewRepositoryDockerHostedService(client).Get(resourceData.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading docker hosted repository %q: %w", resourceData.Id(), err)
 	}
 
 	if repo == nil {
@@ -148,29 +178,34 @@ func resourceDockerHostedRepositoryRead(resourceData *schema.ResourceData, m int
 		return nil
 	}
 
+	setRepositoryFormat(resourceData, repository.RepositoryFormatDocker, repository.RepositoryTypeHosted)
 	return setDockerHostedRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceDockerHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := resourceData.Id()
 	repo := getDockerHostedRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Docker.Hosted.Update(repoName, repo); err != nil {
-		return err
+	if err := api.NewRepositoryDockerHostedService(client).Update(repoName, repo); err != nil {
+		return fmt.Errorf("updating docker hosted repository %q: %w", repoName, err)
 	}
 
 	return resourceDockerHostedRepositoryRead(resourceData, m)
 }
 
 func resourceDockerHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Docker.Hosted.Delete(resourceData.Id())
+	client := m.(*api.Client)
+
+	if err := client.Repository.Docker.Hosted.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting docker hosted repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
 }
 
 func resourceDockerHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Docker.Hosted.Get(resourceData.Id())
 	return repo != nil, err
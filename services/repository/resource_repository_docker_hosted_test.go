@@ -2,17 +2,27 @@ package repository_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceRepositoryDockerHosted() repository.DockerHostedRepository {
@@ -97,3 +107,312 @@ func TestAccResourceRepositoryDockerHosted(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceRepositoryDockerHostedLatestPolicy(t *testing.T) {
+	var created json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/docker/hosted":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/hosted/docker-releases":
+			w.Write(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_hosted"]
+	config := func(writePolicy string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":   "docker-releases",
+			"online": true,
+			"docker": []interface{}{map[string]interface{}{"force_basic_auth": true, "v1_enabled": false, "http_port": 8082}},
+			"storage": []interface{}{map[string]interface{}{
+				"blob_store_name":                "default",
+				"strict_content_type_validation": true,
+				"write_policy":                   writePolicy,
+				"latest_policy":                  true,
+			}},
+		}
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config("ALLOW_ONCE"))
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, string(created), `"latestPolicy":true`)
+	assert.Equal(t, true, resourceData.Get("storage.0.latest_policy"))
+
+	diff, err := res.Diff(context.Background(), resourceData.State(), terraform.NewResourceConfigRaw(config("ALLOW_ONCE")), nexusClient)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "storage.0.latest_policy")
+		assert.NotContains(t, diff.Attributes, "storage.0.write_policy")
+	}
+
+	_, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config("ALLOW")), nexusClient)
+	assert.EqualError(t, err, `storage.0.latest_policy requires storage.0.write_policy to be "ALLOW_ONCE"`)
+}
+
+func TestResourceRepositoryDockerHostedHTTPSCertificateAlias(t *testing.T) {
+	var created json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/docker/hosted":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/hosted/docker-releases":
+			w.Write(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_hosted"]
+	config := func(docker map[string]interface{}) map[string]interface{} {
+		docker["force_basic_auth"] = true
+		docker["v1_enabled"] = false
+		return map[string]interface{}{
+			"name":    "docker-releases",
+			"online":  true,
+			"docker":  []interface{}{docker},
+			"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true, "write_policy": "ALLOW"}},
+		}
+	}
+
+	withAlias := config(map[string]interface{}{"https_port": 8443, "https_certificate_alias": "docker.example.com"})
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, withAlias)
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, string(created), `"httpsCertificateAlias":"docker.example.com"`)
+	assert.Equal(t, "docker.example.com", resourceData.Get("docker.0.https_certificate_alias"))
+
+	diff, err := res.Diff(context.Background(), resourceData.State(), terraform.NewResourceConfigRaw(withAlias), nexusClient)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "docker.0.https_certificate_alias")
+	}
+
+	_, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config(map[string]interface{}{"http_port": 8080, "https_certificate_alias": "docker.example.com"})), nexusClient)
+	assert.EqualError(t, err, "docker.0.https_certificate_alias requires docker.0.https_port")
+}
+
+func TestResourceRepositoryDockerHostedPortConflict(t *testing.T) {
+	created := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"docker-public","format":"docker","type":"group"},{"name":"docker-hub","format":"docker","type":"proxy"},{"name":"npm-private","format":"npm","type":"hosted"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/group/docker-public":
+			fmt.Fprint(w, `{"name":"docker-public","online":true,"storage":{"blobStoreName":"default"},"group":{"memberNames":["docker-hub"]},"docker":{"httpPort":8082}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/proxy/docker-hub":
+			fmt.Fprint(w, `{"name":"docker-hub","online":true,"storage":{"blobStoreName":"default"},"proxy":{},"negativeCache":{},"httpClient":{},"docker":{"httpsPort":8443},"dockerProxy":{"indexType":"HUB"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/docker/hosted":
+			created = true
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_hosted"]
+	create := func(docker map[string]interface{}) diag.Diagnostics {
+		docker["force_basic_auth"] = true
+		docker["v1_enabled"] = false
+		return res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":    "docker-releases",
+			"online":  true,
+			"docker":  []interface{}{docker},
+			"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true, "write_policy": "ALLOW"}},
+		}), nexusClient)
+	}
+
+	diags := create(map[string]interface{}{"http_port": 8082})
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `creating docker hosted repository "docker-releases": docker.0.http_port 8082 is already used by docker group repository "docker-public"`, diags[0].Summary)
+
+	diags = create(map[string]interface{}{"http_port": 8083, "https_port": 8443})
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `creating docker hosted repository "docker-releases": docker.0.https_port 8443 is already used by docker proxy repository "docker-hub"`, diags[0].Summary)
+	assert.False(t, created)
+
+	create(map[string]interface{}{"http_port": 8083})
+	assert.True(t, created)
+}
+
+func TestResourceRepositoryDockerHostedAnonymousPullWarning(t *testing.T) {
+	var created json.RawMessage
+	activeRealms := `["NexusAuthenticatingRealm"]`
+	realmRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/docker/hosted":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/hosted/docker-releases":
+			w.Write(created)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/realms/active":
+			realmRequests++
+			fmt.Fprint(w, activeRealms)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_hosted"]
+	create := func(forceBasicAuth bool) diag.Diagnostics {
+		return res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":    "docker-releases",
+			"online":  true,
+			"docker":  []interface{}{map[string]interface{}{"force_basic_auth": forceBasicAuth, "v1_enabled": false}},
+			"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true, "write_policy": "ALLOW"}},
+		}), nexusClient)
+	}
+
+	diags := create(false)
+	assert.False(t, diags.HasError(), "%v", diags)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, `docker repository "docker-releases" does not allow anonymous pulls yet`, diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "nexus_security_realms")
+	}
+
+	activeRealms = `["NexusAuthenticatingRealm","DockerToken"]`
+	assert.Empty(t, create(false))
+
+	// Realms are irrelevant while authentication is forced
+	realmRequests = 0
+	assert.Empty(t, create(true))
+	assert.Equal(t, 0, realmRequests)
+}
+
+func TestResourceRepositoryDockerHostedConnectors(t *testing.T) {
+	var created json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/docker/hosted":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/docker/hosted/docker-releases":
+			w.Write(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_hosted"]
+	config := func(docker map[string]interface{}) map[string]interface{} {
+		docker["force_basic_auth"] = true
+		docker["v1_enabled"] = false
+		return map[string]interface{}{
+			"name":    "docker-releases",
+			"online":  true,
+			"docker":  []interface{}{docker},
+			"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true, "write_policy": "ALLOW"}},
+		}
+	}
+
+	for _, connector := range []string{"http_port", "https_port"} {
+		onlyOne := config(map[string]interface{}{connector: 8443})
+		_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(onlyOne), nexusClient)
+		assert.NoError(t, err, connector)
+
+		// The other connector is not sent as 0, which would open it
+		created = nil
+		diags := res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, onlyOne), nexusClient)
+		assert.False(t, diags.HasError(), "%v", diags)
+		assert.NotContains(t, string(created), `"httpPort":0`)
+		assert.NotContains(t, string(created), `"httpsPort":0`)
+	}
+
+	// A subdomain connector is enough on its own
+	assert.NoError(t, api.PinServerVersion(nexusClient, "3.38.1"))
+	subdomainOnly := config(map[string]interface{}{"subdomain": "docker-releases"})
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(subdomainOnly), nexusClient)
+	assert.NoError(t, err)
+
+	created = nil
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, subdomainOnly)
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, string(created), `"subdomain":"docker-releases"`)
+	assert.Equal(t, "docker-releases", resourceData.Get("docker.0.subdomain"))
+
+	_, err = res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config(map[string]interface{}{})), nexusClient)
+	assert.EqualError(t, err, "one of docker.0.http_port, docker.0.https_port, docker.0.subdomain is required, otherwise docker clients cannot reach the repository")
+
+	diags = res.Validate(terraform.NewResourceConfigRaw(config(map[string]interface{}{"http_port": 0})))
+	assert.True(t, diags.HasError())
+}
+
+func TestResourceRepositoryDockerHostedSubdomainRequiresNexusVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/status" {
+			w.Header().Set("Server", "Nexus/3.37.3-02 (OSS)")
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, server.Client())
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_hosted"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "docker-releases",
+		"online":  true,
+		"docker":  []interface{}{map[string]interface{}{"force_basic_auth": true, "v1_enabled": false, "subdomain": "docker-releases"}},
+		"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "write_policy": "ALLOW"}},
+	})
+
+	_, err := res.Diff(context.Background(), nil, config, nexusClient)
+	assert.EqualError(t, err, "docker.0.subdomain requires Nexus >= 3.38, but Nexus 3.37.3-02 is used")
+
+	// A copy bound to a context shares the version pinned for its original
+	assert.NoError(t, api.PinServerVersion(nexusClient, "3.38.1"))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err = res.Diff(context.Background(), nil, config, api.WithContext(ctx, nexusClient))
+	assert.NoError(t, err)
+}
@@ -1,14 +1,18 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+
 	"regexp"
 	"strings"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,29 +21,38 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a docker proxy repository.",
 
-		Create: resourceDockerProxyRepositoryCreate,
-		Delete: resourceDockerProxyRepositoryDelete,
-		Exists: resourceDockerProxyRepositoryExists,
-		Read:   resourceDockerProxyRepositoryRead,
-		Update: resourceDockerProxyRepositoryUpdate,
+		CreateContext: createWithCachePriming(createWithAdoption(repository.RepositoryFormatDocker, repository.RepositoryTypeProxy, createWithBlobStoreRetry(resourceDockerProxyRepositoryCreate), resourceDockerProxyRepositoryRead)),
+		DeleteContext: withContext(deleteWithProtection(resourceDockerProxyRepositoryDelete)),
+		Exists:        resourceDockerProxyRepositoryExists,
+		ReadContext:   withContext(resourceDockerProxyRepositoryRead),
+		UpdateContext: withContext(resourceDockerProxyRepositoryUpdate),
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: customdiff.Sequence(resourceDockerProxyRepositoryCustomizeDiff, validateDockerSubdomain, validateDockerHTTPSCertificateAlias, validateDockerConnectors("docker.0.http_port", "docker.0.https_port", "docker.0.subdomain"), validateHTTPClientAuthentication),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatDocker, repository.RepositoryTypeProxy),
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
 			// Proxy schemas
-			"cleanup":        repositorySchema.ResourceCleanup,
-			"http_client":    repositorySchema.ResourceHTTPClient,
-			"negative_cache": repositorySchema.ResourceNegativeCache,
-			"proxy":          repositorySchema.ResourceProxy,
-			"routing_rule":   repositorySchema.ResourceRoutingRule,
-			"storage":        repositorySchema.ResourceStorage,
+			"cleanup":                 repositorySchema.ResourceCleanup,
+			"http_client":             repositorySchema.ResourceHTTPClient,
+			"negative_cache":          repositorySchema.ResourceNegativeCache,
+			"prime_paths":             repositorySchema.ResourcePrimePaths,
+			"prime_strict":            repositorySchema.ResourcePrimeStrict,
+			"verify_remote_on_create": repositorySchema.ResourceVerifyRemoteOnCreate,
+			"proxy":                   repositorySchema.ResourceProxy,
+			"routing_rule":            repositorySchema.ResourceRoutingRule,
+			"storage":                 repositorySchema.ResourceStorage,
 			// Docker proxy schemas
-			"docker": repositorySchema.ResourceDocker,
+			"docker": repositorySchema.ResourceDockerWithSubdomain,
 			"docker_proxy": {
 				Description: "docker_proxy contains the configuration of the docker index",
 				Type:        schema.TypeList,
@@ -47,6 +60,21 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"cache_foreign_layers": {
+							Default:     false,
+							Description: "Allow Nexus Repository Manager to download and cache foreign layers",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+						"foreign_layer_url_whitelist": {
+							Description: "Regular expressions used to identify URLs that are allowed for foreign layer requests",
+							Optional:    true,
+							Type:        schema.TypeList,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+						},
 						"index_type": {
 							Description:  "Type of Docker Index. Possible values: `HUB`, `REGISTRY` or `CUSTOM`",
 							Required:     true,
@@ -54,10 +82,11 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{string(repository.DockerProxyIndexTypeHub), string(repository.DockerProxyIndexTypeRegistry), string(repository.DockerProxyIndexTypeCustom)}, false),
 						},
 						"index_url": {
-							Description:  "Url of Docker Index to use",
-							Optional:     true,
-							Type:         schema.TypeString,
-							ValidateFunc: validation.StringMatch(regexp.MustCompile("http[s]?://.*"), "index_url should be in the format 'http://www.example.com'"),
+							Description:      "Url of Docker Index to use. Required for index_type `CUSTOM`, ignored for `HUB`",
+							Optional:         true,
+							Type:             schema.TypeString,
+							ValidateFunc:     validation.StringMatch(regexp.MustCompile("http[s]?://.*"), "index_url should be in the format 'http://www.example.com'"),
+							DiffSuppressFunc: suppressDockerHubIndexURLDiff,
 						},
 					},
 				},
@@ -66,40 +95,58 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 	}
 }
 
-func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.DockerProxyRepository {
+// Docker Hub has a well-known index, Nexus ignores any index_url given for it
+func suppressDockerHubIndexURLDiff(k, old, new string, resourceData *schema.ResourceData) bool {
+	return resourceData.Get("docker_proxy.0.index_type").(string) == string(repository.DockerProxyIndexTypeHub)
+}
+
+func resourceDockerProxyRepositoryCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Get("docker_proxy.0.index_type").(string) == string(repository.DockerProxyIndexTypeCustom) &&
+		diff.NewValueKnown("docker_proxy.0.index_url") && diff.Get("docker_proxy.0.index_url").(string) == "" {
+		return fmt.Errorf("docker_proxy.0.index_url is required when docker_proxy.0.index_type is %q", repository.DockerProxyIndexTypeCustom)
+	}
+	return nil
+}
+
+func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData) api.DockerProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	dockerConfig := resourceData.Get("docker").([]interface{})[0].(map[string]interface{})
 	dockerProxyConfig := resourceData.Get("docker_proxy").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.DockerProxyRepository{
-		Name:   resourceData.Get("name").(string),
-		Online: resourceData.Get("online").(bool),
-		Storage: repository.Storage{
-			BlobStoreName:               storageConfig["blob_store_name"].(string),
-			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
-		},
-		Docker: repository.Docker{
-			ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
-			V1Enabled:      dockerConfig["v1_enabled"].(bool),
-		},
-		DockerProxy: repository.DockerProxy{
-			IndexType: repository.DockerProxyIndexType(dockerProxyConfig["index_type"].(string)),
-		},
-		HTTPClient: repository.HTTPClient{
-			AutoBlock: httpClientConfig["auto_block"].(bool),
-			Blocked:   httpClientConfig["blocked"].(bool),
+	repo := api.DockerProxyRepository{
+		DockerProxyRepository: repository.DockerProxyRepository{
+			Name:   resourceData.Get("name").(string),
+			Online: resourceData.Get("online").(bool),
+			Storage: repository.Storage{
+				BlobStoreName:               storageConfig["blob_store_name"].(string),
+				StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+			},
+			HTTPClient: repository.HTTPClient{
+				AutoBlock: httpClientConfig["auto_block"].(bool),
+				Blocked:   httpClientConfig["blocked"].(bool),
+			},
+			NegativeCache: getNegativeCacheFromResourceData(resourceData),
+			Proxy: repository.Proxy{
+				ContentMaxAge:  proxyConfig["content_max_age"].(int),
+				MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
+				RemoteURL:      proxyConfig["remote_url"].(string),
+			},
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
+		Docker: api.Docker{
+			Docker: repository.Docker{
+				ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
+				V1Enabled:      dockerConfig["v1_enabled"].(bool),
+			},
+			HTTPSCertificateAlias: getDockerHTTPSCertificateAlias(dockerConfig),
 		},
-		Proxy: repository.Proxy{
-			ContentMaxAge:  proxyConfig["content_max_age"].(int),
-			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
-			RemoteURL:      proxyConfig["remote_url"].(string),
+		DockerProxy: api.DockerProxy{
+			DockerProxy: repository.DockerProxy{
+				IndexType: repository.DockerProxyIndexType(dockerProxyConfig["index_type"].(string)),
+			},
+			CacheForeignLayers:       dockerProxyConfig["cache_foreign_layers"].(bool),
+			ForeignLayerURLWhitelist: tools.InterfaceSliceToStringSlice(dockerProxyConfig["foreign_layer_url_whitelist"].([]interface{})),
 		},
 	}
 
@@ -115,7 +162,9 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		}
 	}
 
-	if dockerProxyConfig["index_url"].(string) != "" {
+	repo.Docker.Subdomain = getDockerSubdomain(dockerConfig)
+
+	if dockerProxyConfig["index_url"].(string) != "" && repo.DockerProxy.IndexType != repository.DockerProxyIndexTypeHub {
 		repo.DockerProxy.IndexURL = tools.GetStringPointer(strings.TrimSpace(dockerProxyConfig["index_url"].(string)))
 	}
 
@@ -170,12 +219,12 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 	return repo
 }
 
-func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyRepository, resourceData *schema.ResourceData) error {
+func setDockerProxyRepositoryToResourceData(repo *api.DockerProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
 
-	if err := resourceData.Set("docker", flattenDocker(&repo.Docker)); err != nil {
+	if err := resourceData.Set("docker", flattenDockerWithCertificateAlias(&repo.Docker)); err != nil {
 		return err
 	}
 
@@ -197,7 +246,7 @@ func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyReposito
 		return err
 	}
 
-	if err := resourceData.Set("negative_cache", flattenNegativeCache(&repo.NegativeCache)); err != nil {
+	if err := resourceData.Set("negative_cache", flattenNegativeCache(normalizeNegativeCache(&repo.NegativeCache, resourceData))); err != nil {
 		return err
 	}
 
@@ -206,7 +255,7 @@ func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyReposito
 	}
 
 	if repo.Cleanup != nil {
-		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
 			return err
 		}
 	}
@@ -214,24 +263,24 @@ func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyReposito
 }
 
 func resourceDockerProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getDockerProxyRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Docker.Proxy.Create(repo); err != nil {
-		return err
+	if err := api.NewRepositoryDockerProxyService(client).Create(repo); err != nil {
+		return fmt.Errorf("creating docker proxy repository %q: %w", repo.Name, err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceDockerProxyRepositoryRead(resourceData, m)
+	return readRepositoryAfterCreate(resourceData, m, resourceDockerProxyRepositoryRead)
 }
 
 func resourceDockerProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
-	repo, err := client.Repository.Docker.Proxy.Get(resourceData.Id())
+	repo, err := api.NewRepositoryDockerProxyService(client).Get(resourceData.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading docker proxy repository %q: %w", resourceData.Id(), err)
 	}
 
 	if repo == nil {
@@ -239,30 +288,35 @@ func resourceDockerProxyRepositoryRead(resourceData *schema.ResourceData, m inte
 		return nil
 	}
 
+	setRepositoryFormat(resourceData, repository.RepositoryFormatDocker, repository.RepositoryTypeProxy)
 	return setDockerProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceDockerProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := resourceData.Id()
 	repo := getDockerProxyRepositoryFromResourceData(resourceData)
 
-	if err := client.Repository.Docker.Proxy.Update(repoName, repo); err != nil {
-		return err
+	if err := api.NewRepositoryDockerProxyService(client).Update(repoName, repo); err != nil {
+		return fmt.Errorf("updating docker proxy repository %q: %w", repoName, err)
 	}
 
 	return resourceDockerProxyRepositoryRead(resourceData, m)
 }
 
 func resourceDockerProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Docker.Proxy.Delete(resourceData.Id())
+	client := m.(*api.Client)
+
+	if err := client.Repository.Docker.Proxy.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting docker proxy repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
 }
 
 func resourceDockerProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
-	repo, err := client.Repository.Docker.Proxy.Get(resourceData.Id())
+	repo, err := api.NewRepositoryDockerProxyService(client).Get(resourceData.Id())
 	return repo != nil, err
 }
@@ -2,40 +2,39 @@ package repository_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	nexusSchema "github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
-func testAccResourceRepositoryDockerProxy() repository.DockerProxyRepository {
+func testAccResourceRepositoryDockerProxy() api.DockerProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.DockerProxyRepository{
+	repo := api.DockerProxyRepository{}
+	repo.DockerProxyRepository = repository.DockerProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
-		DockerProxy: repository.DockerProxy{
-			IndexType: repository.DockerProxyIndexTypeRegistry,
-			IndexURL:  tools.GetStringPointer("https://docker.elastic.co/index.json"),
-		},
-		Docker: repository.Docker{
-			ForceBasicAuth: false,
-			HTTPPort:       tools.GetIntPointer(rand.Intn(999) + 34000),
-			HTTPSPort:      tools.GetIntPointer(rand.Intn(999) + 35000),
-			V1Enabled:      true,
-		},
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
 			StrictContentTypeValidation: true,
@@ -70,9 +69,25 @@ func testAccResourceRepositoryDockerProxy() repository.DockerProxyRepository {
 			RemoteURL:      "https://docker.elastic.co",
 		},
 	}
+	repo.Docker = api.Docker{
+		Docker: repository.Docker{
+			ForceBasicAuth: false,
+			HTTPPort:       tools.GetIntPointer(rand.Intn(999) + 34000),
+			HTTPSPort:      tools.GetIntPointer(rand.Intn(999) + 35000),
+			V1Enabled:      true,
+		},
+	}
+	repo.DockerProxy = api.DockerProxy{
+		DockerProxy: repository.DockerProxy{
+			IndexType: repository.DockerProxyIndexTypeRegistry,
+			IndexURL:  tools.GetStringPointer("https://docker.elastic.co/index.json"),
+		},
+	}
+
+	return repo
 }
 
-func testAccResourceRepositoryDockerProxyConfig(repo repository.DockerProxyRepository) string {
+func testAccResourceRepositoryDockerProxyConfig(repo api.DockerProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryDockerProxyTemplate := template.Must(template.New("DockerProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryDockerProxy))
 	if err := resourceRepositoryDockerProxyTemplate.Execute(buf, repo); err != nil {
@@ -82,10 +97,10 @@ func testAccResourceRepositoryDockerProxyConfig(repo repository.DockerProxyRepos
 }
 
 func TestAccResourceRepositoryDockerProxy(t *testing.T) {
-	routingRule := schema.RoutingRule{
+	routingRule := nexusSchema.RoutingRule{
 		Name:        acctest.RandString(10),
 		Description: "acceptance test",
-		Mode:        schema.RoutingRuleModeAllow,
+		Mode:        nexusSchema.RoutingRuleModeAllow,
 		Matchers: []string{
 			"/",
 		},
@@ -145,6 +160,8 @@ func TestAccResourceRepositoryDockerProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.index_type", string(repo.DockerProxy.IndexType)),
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.index_url", *repo.DockerProxy.IndexURL),
+						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.cache_foreign_layers", "false"),
+						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.foreign_layer_url_whitelist.#", "0"),
 					),
 				),
 			},
@@ -158,3 +175,154 @@ func TestAccResourceRepositoryDockerProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryDockerProxyForeignLayers(t *testing.T) {
+	repo := testAccResourceRepositoryDockerProxy()
+	repo.DockerProxy = api.DockerProxy{
+		DockerProxy: repository.DockerProxy{
+			IndexType: repository.DockerProxyIndexTypeHub,
+		},
+		CacheForeignLayers:       true,
+		ForeignLayerURLWhitelist: []string{"https://*.docker.io"},
+	}
+	repo.Proxy.RemoteURL = "https://registry-1.docker.io"
+	resourceName := "nexus_repository_docker_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryDockerProxyConfig(repo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "docker_proxy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.index_type", string(repo.DockerProxy.IndexType)),
+					resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.cache_foreign_layers", strconv.FormatBool(repo.DockerProxy.CacheForeignLayers)),
+					resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.foreign_layer_url_whitelist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.foreign_layer_url_whitelist.0", repo.DockerProxy.ForeignLayerURLWhitelist[0]),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           repo.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.password"},
+			},
+		},
+	})
+}
+
+func testDockerProxyIndexConfig(dockerProxy map[string]interface{}) *terraform.ResourceConfig {
+	return terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "docker-proxy",
+		"online":         true,
+		"docker":         []interface{}{map[string]interface{}{"force_basic_auth": true, "v1_enabled": false, "http_port": 8082}},
+		"docker_proxy":   []interface{}{dockerProxy},
+		"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+		"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+		"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://registry-1.docker.io"}},
+		"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+	})
+}
+
+func TestResourceRepositoryDockerProxyIndexHub(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_proxy"]
+
+	_, err := res.Diff(context.Background(), nil, testDockerProxyIndexConfig(map[string]interface{}{
+		"index_type": string(repository.DockerProxyIndexTypeHub),
+	}), nil)
+	assert.NoError(t, err)
+
+	// An index_url given for Docker Hub is ignored
+	state := &terraform.InstanceState{
+		ID: "docker-proxy",
+		Attributes: map[string]string{
+			"id":                                  "docker-proxy",
+			"docker_proxy.#":                      "1",
+			"docker_proxy.0.index_type":           string(repository.DockerProxyIndexTypeHub),
+			"docker_proxy.0.index_url":            "",
+			"docker_proxy.0.cache_foreign_layers": "false",
+		},
+	}
+	diff, err := res.Diff(context.Background(), state, testDockerProxyIndexConfig(map[string]interface{}{
+		"index_type": string(repository.DockerProxyIndexTypeHub),
+		"index_url":  "https://index.docker.io/",
+	}), nil)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "docker_proxy.0.index_url")
+	}
+}
+
+func TestResourceRepositoryDockerProxyIndexCustom(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_proxy"]
+
+	_, err := res.Diff(context.Background(), nil, testDockerProxyIndexConfig(map[string]interface{}{
+		"index_type": string(repository.DockerProxyIndexTypeCustom),
+	}), nil)
+	assert.EqualError(t, err, `docker_proxy.0.index_url is required when docker_proxy.0.index_type is "CUSTOM"`)
+
+	_, err = res.Diff(context.Background(), nil, testDockerProxyIndexConfig(map[string]interface{}{
+		"index_type": string(repository.DockerProxyIndexTypeCustom),
+		"index_url":  "https://docker.example.com/",
+	}), nil)
+	assert.NoError(t, err)
+}
+
+func TestResourceRepositoryDockerProxyForeignLayerURLWhitelist(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_proxy"]
+	whitelist := res.Schema["docker_proxy"].Elem.(*schema.Resource).Schema["foreign_layer_url_whitelist"].Elem.(*schema.Schema)
+
+	// Nexus matches the entries as regular expressions against the URLs
+	for _, entry := range []string{".*", "https://.*\\.example\\.com/.*", "^http://mirror/"} {
+		_, errs := whitelist.ValidateFunc(entry, "foreign_layer_url_whitelist")
+		assert.Empty(t, errs, entry)
+	}
+
+	_, errs := whitelist.ValidateFunc("https://(example.com", "foreign_layer_url_whitelist")
+	assert.NotEmpty(t, errs)
+}
+
+func TestResourceRepositoryDockerProxySubdomainRequiresNexusVersion(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_docker_proxy"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "docker-proxy",
+		"online":         true,
+		"docker":         []interface{}{map[string]interface{}{"force_basic_auth": true, "v1_enabled": false, "subdomain": "docker-proxy"}},
+		"docker_proxy":   []interface{}{map[string]interface{}{"index_type": string(repository.DockerProxyIndexTypeHub)}},
+		"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+		"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+		"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://registry-1.docker.io"}},
+		"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+	})
+
+	for serverHeader, expectedErr := range map[string]string{
+		"Nexus/3.37.3-02 (OSS)": "docker.0.subdomain requires Nexus >= 3.38, but Nexus 3.37.3-02 is used",
+		"Nexus/3.38.1-01 (PRO)": "",
+		"":                      "",
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/status" {
+				if serverHeader != "" {
+					w.Header().Set("Server", serverHeader)
+				}
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+
+		nexusClient := api.NewClient(client.Config{
+			URL:      server.URL,
+			Username: "admin",
+			Password: "admin123",
+		}, nil)
+		_, err := res.Diff(context.Background(), nil, config, nexusClient)
+		if expectedErr == "" {
+			assert.NoError(t, err, serverHeader)
+		} else {
+			assert.EqualError(t, err, expectedErr)
+		}
+		server.Close()
+	}
+}
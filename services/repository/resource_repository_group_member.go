@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Terraform applies the members of a group in parallel, so the
+// read-modify-write of a group's member list is serialized per group
+var repositoryGroupLocks sync.Map
+
+func lockRepositoryGroup(name string) func() {
+	lock, _ := repositoryGroupLocks.LoadOrStore(name, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+func ResourceRepositoryGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to add a single member to an existing group repository of any format.
+
+This allows several teams to manage the members of one group independently. The group repository itself must not manage the members in that case, e.g. ignore changes to its ` + "`group`" + ` block via ` + "`lifecycle { ignore_changes = [group] }`" + `.`,
+
+		Create: resourceRepositoryGroupMemberCreate,
+		Read:   resourceRepositoryGroupMemberRead,
+		Delete: resourceRepositoryGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"group": {
+				Description: "Name of the group repository",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"member": {
+				Description: "Name of the repository to add to the group",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func parseRepositoryGroupMemberID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid group member ID %q, expected <group>/<member>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// getRepositoryGroup reads the current state of the group repository with the
+// given name, looking up its format in the repository list
+func getRepositoryGroup(client *api.Client, name string) (*api.RepositoryGroup, error) {
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range repositories {
+		if repo.Name != name {
+			continue
+		}
+		if repo.Type != repository.RepositoryTypeGroup {
+			return nil, fmt.Errorf("repository is a %s repository, expected a group repository", repo.Type)
+		}
+		return api.NewRepositoryGroupService(client).Get(repo.Format, name)
+	}
+	return nil, nil
+}
+
+func containsRepositoryGroupMember(group *api.RepositoryGroup, member string) bool {
+	for _, memberName := range group.MemberNames {
+		if memberName == member {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceRepositoryGroupMemberCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	groupName := resourceData.Get("group").(string)
+	member := resourceData.Get("member").(string)
+
+	unlock := lockRepositoryGroup(groupName)
+	defer unlock()
+
+	// Always start from a fresh read to not overwrite members added in the meantime
+	group, err := getRepositoryGroup(client, groupName)
+	if err != nil {
+		return fmt.Errorf("adding member %q to group repository %q: %w", member, groupName, err)
+	}
+	if group == nil {
+		return fmt.Errorf("adding member %q to group repository %q: group repository not found", member, groupName)
+	}
+
+	if !containsRepositoryGroupMember(group, member) {
+		group.MemberNames = append(group.MemberNames, member)
+		if err := api.NewRepositoryGroupService(client).Update(group); err != nil {
+			return fmt.Errorf("adding member %q to group repository %q: %w", member, groupName, err)
+		}
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", groupName, member))
+	return resourceRepositoryGroupMemberRead(resourceData, m)
+}
+
+func resourceRepositoryGroupMemberRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	groupName, member, err := parseRepositoryGroupMemberID(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	group, err := getRepositoryGroup(client, groupName)
+	if err != nil {
+		return fmt.Errorf("reading member %q of group repository %q: %w", member, groupName, err)
+	}
+	if group == nil || !containsRepositoryGroupMember(group, member) {
+		resourceData.SetId("")
+		return nil
+	}
+
+	resourceData.Set("group", groupName)
+	resourceData.Set("member", member)
+
+	return nil
+}
+
+func resourceRepositoryGroupMemberDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	groupName, member, err := parseRepositoryGroupMemberID(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	unlock := lockRepositoryGroup(groupName)
+	defer unlock()
+
+	group, err := getRepositoryGroup(client, groupName)
+	if err != nil {
+		return fmt.Errorf("removing member %q from group repository %q: %w", member, groupName, err)
+	}
+	if group == nil || !containsRepositoryGroupMember(group, member) {
+		return nil
+	}
+
+	memberNames := []string{}
+	for _, memberName := range group.MemberNames {
+		if memberName != member {
+			memberNames = append(memberNames, memberName)
+		}
+	}
+	group.MemberNames = memberNames
+
+	if err := api.NewRepositoryGroupService(client).Update(group); err != nil {
+		return fmt.Errorf("removing member %q from group repository %q: %w", member, groupName, err)
+	}
+	return nil
+}
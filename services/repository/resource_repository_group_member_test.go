@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceRepositoryGroupMember(t *testing.T) {
+	var mutex sync.Mutex
+	members := []string{"maven-releases"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"maven-public","format":"maven2","type":"group"},{"name":"maven-releases","format":"maven2","type":"hosted"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/maven/group/maven-public":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"name":    "maven-public",
+				"online":  true,
+				"storage": map[string]interface{}{"blobStoreName": "default", "strictContentTypeValidation": true},
+				"group":   map[string]interface{}{"memberNames": members},
+			})
+		case r.Method == http.MethodPut && r.URL.Path == "/service/rest/v1/repositories/maven/group/maven-public":
+			var repo struct {
+				Storage map[string]interface{} `json:"storage"`
+				Group   struct {
+					MemberNames []string `json:"memberNames"`
+				} `json:"group"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&repo))
+			assert.Equal(t, "default", repo.Storage["blobStoreName"])
+			members = repo.Group.MemberNames
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_group_member"]
+
+	// Two members of the same group applied in parallel, as Terraform would
+	resourceDatas := []*schema.ResourceData{
+		schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"group": "maven-public", "member": "team-a-releases"}),
+		schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"group": "maven-public", "member": "team-b-releases"}),
+	}
+	var wg sync.WaitGroup
+	for _, resourceData := range resourceDatas {
+		wg.Add(1)
+		go func(resourceData *schema.ResourceData) {
+			defer wg.Done()
+			assert.NoError(t, res.Create(resourceData, nexusClient))
+		}(resourceData)
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, []string{"maven-releases", "team-a-releases", "team-b-releases"}, members)
+	assert.Equal(t, "maven-public/team-a-releases", resourceDatas[0].Id())
+	assert.Equal(t, "maven-public/team-b-releases", resourceDatas[1].Id())
+
+	assert.NoError(t, res.Delete(resourceDatas[0], nexusClient))
+	assert.ElementsMatch(t, []string{"maven-releases", "team-b-releases"}, members)
+
+	// A member which is no longer part of the group is removed from state
+	assert.NoError(t, res.Read(resourceDatas[0], nexusClient))
+	assert.Equal(t, "", resourceDatas[0].Id())
+}
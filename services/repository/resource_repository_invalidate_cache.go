@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryInvalidateCache() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to invalidate the cache of a proxy or group repository, e.g. after the remote republished an artifact.
+
+The cache is invalidated once when the resource is created. Change ` + "`triggers`" + ` to invalidate it again. Destroying the resource does not change anything in Nexus.`,
+
+		Create: resourceRepositoryInvalidateCacheCreate,
+		Read:   resourceRepositoryTaskRead,
+		Delete: resourceRepositoryTaskDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "Name of the proxy or group repository to invalidate the cache of",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"triggers": {
+				Description: "Arbitrary values which invalidate the cache again when they change",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+			},
+		},
+	}
+}
+
+func resourceRepositoryInvalidateCacheCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	repositoryName := resourceData.Get("repository").(string)
+
+	if err := checkRepositoryHasCache(client, repositoryName); err != nil {
+		return fmt.Errorf("invalidating cache of repository %q: %w", repositoryName, err)
+	}
+	if err := api.NewRepositoryCacheService(client).Invalidate(repositoryName); err != nil {
+		return fmt.Errorf("invalidating cache of repository %q: %w", repositoryName, err)
+	}
+
+	resourceData.SetId(repositoryName)
+	return nil
+}
+
+// checkRepositoryHasCache returns an error if the repository does not exist
+// or is a hosted repository, which has no cache to invalidate
+func checkRepositoryHasCache(client *api.Client, name string) error {
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return err
+	}
+
+	for _, repo := range repositories {
+		if repo.Name != name {
+			continue
+		}
+		if repo.Type == repository.RepositoryTypeHosted {
+			return fmt.Errorf("repository is a %s hosted repository, only proxy and group repositories have a cache", repo.Format)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("repository not found")
+}
@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceRepositoryInvalidateCache(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_invalidate_cache"]
+
+	var invalidated []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[
+				{"name": "maven-central", "format": "maven2", "type": "proxy"},
+				{"name": "maven-public", "format": "maven2", "type": "group"},
+				{"name": "maven-releases", "format": "maven2", "type": "hosted"}
+			]`)
+		case r.Method == http.MethodPost:
+			invalidated = append(invalidated, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	for _, name := range []string{"maven-central", "maven-public"} {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": name})
+		assert.NoError(t, res.Create(resourceData, nexusClient))
+		assert.Equal(t, name, resourceData.Id())
+	}
+	assert.Equal(t, []string{
+		"/service/rest/v1/repositories/maven-central/invalidate-cache",
+		"/service/rest/v1/repositories/maven-public/invalidate-cache",
+	}, invalidated)
+
+	// Hosted repositories have no cache and are never invalidated
+	invalidated = nil
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "maven-releases"})
+	err := res.Create(resourceData, nexusClient)
+	assert.ErrorContains(t, err, "hosted repository")
+	assert.Empty(t, resourceData.Id())
+	assert.Empty(t, invalidated)
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "missing"})
+	assert.ErrorContains(t, res.Create(resourceData, nexusClient), "repository not found")
+	assert.Empty(t, invalidated)
+}
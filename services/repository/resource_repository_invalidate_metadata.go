@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryInvalidateMetadata() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to make a maven proxy repository fetch maven-metadata.xml from the remote again, e.g. after the remote published a new version which must be available before ` + "`proxy.metadata_max_age`" + ` expires.
+
+Nexus has no API to invalidate the cached metadata alone, so the whole cache of the proxy is invalidated. Cached artifacts are not deleted, Nexus only verifies them against the remote on their next request.
+
+The metadata is invalidated once when the resource is created. Change ` + "`triggers`" + ` to invalidate it again. Destroying the resource does not change anything in Nexus.`,
+
+		Create: resourceRepositoryInvalidateMetadataCreate,
+		Read:   resourceRepositoryTaskRead,
+		Delete: resourceRepositoryTaskDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "Name of the maven proxy repository to invalidate the metadata of",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"triggers": {
+				Description: "Arbitrary values which invalidate the metadata again when they change",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+			},
+		},
+	}
+}
+
+func resourceRepositoryInvalidateMetadataCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	repositoryName := resourceData.Get("repository").(string)
+
+	if err := checkRepositoryFormat(client, repositoryName, repository.RepositoryFormatMaven2, repository.RepositoryTypeProxy); err != nil {
+		return fmt.Errorf("invalidating metadata of repository %q: %w", repositoryName, err)
+	}
+	if err := api.NewRepositoryCacheService(client).Invalidate(repositoryName); err != nil {
+		return fmt.Errorf("invalidating metadata of repository %q: %w", repositoryName, err)
+	}
+
+	resourceData.SetId(repositoryName)
+	return nil
+}
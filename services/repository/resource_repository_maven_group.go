@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryMavenGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to create a group maven repository.
+
+Nexus versions which don't keep the maven settings of a group ignore the ` + "`maven`" + ` block. The ` + "`version_policy`" + ` is checked against the members nonetheless, a group with a ` + "`RELEASE`" + ` or ` + "`SNAPSHOT`" + ` version policy warns about members with the other version policy.`,
+
+		CreateContext: resourceMavenGroupRepositoryCreateContext,
+		DeleteContext: withContext(deleteWithProtection(resourceMavenGroupRepositoryDelete)),
+		Exists:        resourceMavenGroupRepositoryExists,
+		ReadContext:   withContext(resourceMavenGroupRepositoryRead),
+		UpdateContext: resourceMavenGroupRepositoryUpdateContext,
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: validateGroupMemberNames,
+		Importer: &schema.ResourceImporter{
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatMaven2, repository.RepositoryTypeGroup),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
+			// Group schemas
+			"group":                  repositorySchema.ResourceGroup,
+			"member_order_sensitive": repositorySchema.ResourceGroupMemberOrderSensitive,
+			"storage":                repositorySchema.ResourceStorage,
+			// Maven group schemas
+			"maven": repositorySchema.ResourceMavenGroup,
+		},
+	}
+}
+
+// mavenGroupVersionPolicyWarnings warns about members of a RELEASE group
+// which store snapshots and vice versa. Clients would never get those
+// artifacts through the group as they expect. Members which are no maven
+// hosted or proxy repositories are not checked.
+func mavenGroupVersionPolicyWarnings(resourceData *schema.ResourceData, client *api.Client) diag.Diagnostics {
+	groupPolicy := repository.MavenVersionPolicy(resourceData.Get("maven.0.version_policy").(string))
+	if groupPolicy != repository.MavenVersionPolicyRelease && groupPolicy != repository.MavenVersionPolicySnapshot {
+		return nil
+	}
+	name := resourceData.Get("name").(string)
+
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("could not check the version policies of the members of maven group repository %q", name),
+			Detail:   err.Error(),
+		}}
+	}
+	memberTypes := map[string]string{}
+	for _, repo := range repositories {
+		if repo.Format == repository.RepositoryFormatMaven2 {
+			memberTypes[repo.Name] = repo.Type
+		}
+	}
+
+	mismatches := []string{}
+	for _, memberName := range resourceData.Get("group.0.member_names").([]interface{}) {
+		member := memberName.(string)
+		var memberMaven *repository.Maven
+		switch memberTypes[member] {
+		case repository.RepositoryTypeHosted:
+			if repo, err := client.Repository.Maven.Hosted.Get(member); err == nil && repo != nil {
+				memberMaven = &repo.Maven
+			}
+		case repository.RepositoryTypeProxy:
+			if repo, err := client.Repository.Maven.Proxy.Get(member); err == nil && repo != nil {
+				memberMaven = &repo.Maven
+			}
+		}
+		if memberMaven == nil || memberMaven.VersionPolicy == nil {
+			continue
+		}
+		if memberPolicy := *memberMaven.VersionPolicy; memberPolicy != groupPolicy && memberPolicy != repository.MavenVersionPolicyMixed {
+			mismatches = append(mismatches, fmt.Sprintf("%s (%s)", member, memberPolicy))
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("maven group repository %q with version_policy %q has members with another version policy", name, groupPolicy),
+		Detail: fmt.Sprintf("The members %s don't store %s artifacts. Remove them from the group or use version_policy %q for a group of releases and snapshots.",
+			strings.Join(mismatches, ", "), strings.ToLower(string(groupPolicy)), repository.MavenVersionPolicyMixed),
+	}}
+}
+
+func resourceMavenGroupRepositoryCreateContext(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := withContext(createWithAdoption(repository.RepositoryFormatMaven2, repository.RepositoryTypeGroup, createWithBlobStoreRetry(resourceMavenGroupRepositoryCreate), resourceMavenGroupRepositoryRead))(ctx, resourceData, m)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, mavenGroupVersionPolicyWarnings(resourceData, m.(*api.Client))...)
+}
+
+func resourceMavenGroupRepositoryUpdateContext(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := withContext(resourceMavenGroupRepositoryUpdate)(ctx, resourceData, m)
+	if diags.HasError() {
+		return diags
+	}
+	if resourceData.HasChanges("maven.0.version_policy", "group.0.member_names") {
+		diags = append(diags, mavenGroupVersionPolicyWarnings(resourceData, m.(*api.Client))...)
+	}
+	return diags
+}
+
+func getMavenGroupRepositoryFromResourceData(resourceData *schema.ResourceData) api.MavenGroupRepository {
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
+	groupMemberNames := []string{}
+	for _, name := range groupConfig["member_names"].([]interface{}) {
+		groupMemberNames = append(groupMemberNames, name.(string))
+	}
+
+	repo := api.MavenGroupRepository{
+		MavenGroupRepository: repository.MavenGroupRepository{
+			Name:   resourceData.Get("name").(string),
+			Online: resourceData.Get("online").(bool),
+			Storage: repository.Storage{
+				BlobStoreName:               storageConfig["blob_store_name"].(string),
+				StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+			},
+			Group: repository.Group{
+				MemberNames: groupMemberNames,
+			},
+		},
+	}
+
+	mavenList := resourceData.Get("maven").([]interface{})
+	if len(mavenList) > 0 && mavenList[0] != nil {
+		mavenConfig := mavenList[0].(map[string]interface{})
+		repo.Maven = &repository.Maven{}
+		if mavenConfig["version_policy"] != "" {
+			versionPolicy := repository.MavenVersionPolicy(mavenConfig["version_policy"].(string))
+			repo.Maven.VersionPolicy = &versionPolicy
+		}
+		if mavenConfig["layout_policy"] != "" {
+			layoutPolicy := repository.MavenLayoutPolicy(mavenConfig["layout_policy"].(string))
+			repo.Maven.LayoutPolicy = &layoutPolicy
+		}
+		if mavenConfig["content_disposition"] != "" {
+			contentDisposition := repository.MavenContentDisposition(mavenConfig["content_disposition"].(string))
+			repo.Maven.ContentDisposition = &contentDisposition
+		}
+	}
+
+	return repo
+}
+
+func setMavenGroupRepositoryToResourceData(repo *api.MavenGroupRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	// Keep the configured maven settings if Nexus does not report them
+	if repo.Maven != nil {
+		if err := resourceData.Set("maven", flattenMaven(repo.Maven)); err != nil {
+			return err
+		}
+	}
+
+	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("group", flattenGroup(&repo.Group)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceMavenGroupRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repo := getMavenGroupRepositoryFromResourceData(resourceData)
+
+	if err := api.NewRepositoryMavenGroupService(client).Create(repo); err != nil {
+		return fmt.Errorf("creating maven group repository %q: %w", repo.Name, err)
+	}
+	resourceData.SetId(repo.Name)
+
+	return readRepositoryAfterCreate(resourceData, m, resourceMavenGroupRepositoryRead)
+}
+
+func resourceMavenGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repo, err := api.NewRepositoryMavenGroupService(client).Get(resourceData.Id())
+	if err != nil {
+		return fmt.Errorf("reading maven group repository %q: %w", resourceData.Id(), err)
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	setRepositoryFormat(resourceData, repository.RepositoryFormatMaven2, repository.RepositoryTypeGroup)
+	return setMavenGroupRepositoryToResourceData(repo, resourceData)
+}
+
+func resourceMavenGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repoName := resourceData.Id()
+	repo := getMavenGroupRepositoryFromResourceData(resourceData)
+
+	if err := api.NewRepositoryMavenGroupService(client).Update(repoName, repo); err != nil {
+		return fmt.Errorf("updating maven group repository %q: %w", repoName, err)
+	}
+
+	return resourceMavenGroupRepositoryRead(resourceData, m)
+}
+
+func resourceMavenGroupRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	if err := client.Repository.Maven.Group.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting maven group repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
+}
+
+func resourceMavenGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*api.Client)
+
+	repo, err := client.Repository.Maven.Group.Get(resourceData.Id())
+	return repo != nil, err
+}
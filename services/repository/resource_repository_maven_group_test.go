@@ -0,0 +1,161 @@
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccResourceRepositoryMavenGroup() api.MavenGroupRepository {
+	versionPolicy := repository.MavenVersionPolicyRelease
+
+	return api.MavenGroupRepository{
+		MavenGroupRepository: repository.MavenGroupRepository{
+			Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
+			Online: true,
+			Storage: repository.Storage{
+				BlobStoreName:               "default",
+				StrictContentTypeValidation: true,
+			},
+			Group: repository.Group{
+				MemberNames: []string{},
+			},
+		},
+		Maven: &repository.Maven{
+			VersionPolicy: &versionPolicy,
+		},
+	}
+}
+
+func testAccResourceRepositoryMavenGroupConfig(repo api.MavenGroupRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryMavenGroupTemplate := template.Must(template.New("MavenGroupRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryMavenGroup))
+	if err := resourceRepositoryMavenGroupTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryMavenGroup(t *testing.T) {
+	repoHosted := testAccResourceRepositoryMavenHosted()
+	repo := testAccResourceRepositoryMavenGroup()
+	repo.Group.MemberNames = append(repo.Group.MemberNames, repoHosted.Name)
+	resourceName := "nexus_repository_maven_group.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryMavenHostedConfig(repoHosted) + testAccResourceRepositoryMavenGroupConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
+						resource.TestCheckResourceAttr(resourceName, "group.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "group.0.member_names.0", repo.Group.MemberNames[0]),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "maven.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "maven.0.version_policy", string(*repo.Maven.VersionPolicy)),
+					),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           repo.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"maven"},
+			},
+		},
+	})
+}
+
+// fakeMavenGroupServer serves a maven group of the given members, which are
+// maven hosted repositories with the given version policies
+func fakeMavenGroupServer(memberPolicies map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/maven/group":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/maven/group/maven-public":
+			// Like Nexus versions which don't keep the maven settings of a group
+			fmt.Fprint(w, `{"name":"maven-public","online":true,"storage":{"blobStoreName":"default"},"group":{"memberNames":["maven-releases","maven-snapshots"]}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			repositories := []string{}
+			for name := range memberPolicies {
+				repositories = append(repositories, fmt.Sprintf(`{"name":%q,"format":"maven2","type":"hosted"}`, name))
+			}
+			fmt.Fprintf(w, "[%s]", strings.Join(repositories, ","))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/service/rest/v1/repositories/maven/hosted/"):
+			name := strings.TrimPrefix(r.URL.Path, "/service/rest/v1/repositories/maven/hosted/")
+			fmt.Fprintf(w, `{"name":%q,"online":true,"storage":{"blobStoreName":"default"},"maven":{"versionPolicy":%q}}`, name, memberPolicies[name])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestResourceRepositoryMavenGroupVersionPolicyWarning(t *testing.T) {
+	server := fakeMavenGroupServer(map[string]string{"maven-releases": "RELEASE", "maven-snapshots": "SNAPSHOT"})
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_group"]
+	create := func(versionPolicy string) (*schema.ResourceData, diag.Diagnostics) {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":    "maven-public",
+			"online":  true,
+			"group":   []interface{}{map[string]interface{}{"member_names": []interface{}{"maven-releases", "maven-snapshots"}}},
+			"maven":   []interface{}{map[string]interface{}{"version_policy": versionPolicy}},
+			"storage": []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+		})
+		return resourceData, res.CreateContext(context.Background(), resourceData, nexusClient)
+	}
+
+	resourceData, diags := create("RELEASE")
+	assert.False(t, diags.HasError(), "%v", diags)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, `maven group repository "maven-public" with version_policy "RELEASE" has members with another version policy`, diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "The members maven-snapshots (SNAPSHOT) don't store release artifacts.")
+	}
+	// The configured maven settings are kept if Nexus does not report them
+	assert.Equal(t, "RELEASE", resourceData.Get("maven.0.version_policy"))
+
+	_, diags = create("SNAPSHOT")
+	if assert.Len(t, diags, 1) {
+		assert.Contains(t, diags[0].Detail, "The members maven-releases (RELEASE) don't store snapshot artifacts.")
+	}
+
+	_, diags = create("MIXED")
+	assert.Empty(t, diags)
+}
@@ -1,10 +1,14 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,20 +17,26 @@ func ResourceRepositoryMavenHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted maven repository.",
 
-		Create: resourceMavenHostedRepositoryCreate,
-		Delete: resourceMavenHostedRepositoryDelete,
-		Exists: resourceMavenHostedRepositoryExists,
-		Read:   resourceMavenHostedRepositoryRead,
-		Update: resourceMavenHostedRepositoryUpdate,
+		CreateContext: withContext(createWithAdoption(repository.RepositoryFormatMaven2, repository.RepositoryTypeHosted, createWithBlobStoreRetry(resourceMavenHostedRepositoryCreate), resourceMavenHostedRepositoryRead)),
+		DeleteContext: withContext(deleteWithProtection(deleteWithComponentPurge(resourceMavenHostedRepositoryDelete))),
+		Exists:        resourceMavenHostedRepositoryExists,
+		ReadContext:   withContext(resourceMavenHostedRepositoryRead),
+		UpdateContext: withContext(resourceMavenHostedRepositoryUpdate),
+		Timeouts:      repositoryTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatMaven2, repository.RepositoryTypeHosted),
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
+			"purge_on_destroy":    repositorySchema.ResourcePurgeOnDestroy,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -34,9 +44,134 @@ func ResourceRepositoryMavenHosted() *schema.Resource {
 			// Maven hosted schemas
 			"maven": repositorySchema.ResourceMaven,
 		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceMavenHostedRepositoryV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceMavenHostedRepositoryStateUpgradeV0,
+				Version: 0,
+			},
+		},
 	}
 }
 
+// resourceMavenHostedRepositoryV0 is the resource of schema version 0 as
+// released before deletion_protection, adopt_existing, format, type,
+// purge_on_destroy and timeouts were added. It must not change anymore, as it
+// describes the states written by older providers.
+func resourceMavenHostedRepositoryV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Computed: true,
+				Type:     schema.TypeString,
+			},
+			"name": {
+				Required: true,
+				Type:     schema.TypeString,
+			},
+			"online": {
+				Default:  true,
+				Optional: true,
+				Type:     schema.TypeBool,
+			},
+			"cleanup": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_names": {
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+							Set: func(v interface{}) int {
+								return schema.HashString(strings.ToLower(v.(string)))
+							},
+							Type: schema.TypeSet,
+						},
+					},
+				},
+			},
+			"component": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"proprietary_components": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"storage": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blob_store_name": {
+							Required: true,
+							Type:     schema.TypeString,
+						},
+						"strict_content_type_validation": {
+							Required: true,
+							Type:     schema.TypeBool,
+						},
+						"write_policy": {
+							Default:  "ALLOW",
+							Optional: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"maven": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_policy": {
+							Optional: true,
+							Type:     schema.TypeString,
+						},
+						"layout_policy": {
+							Optional: true,
+							Type:     schema.TypeString,
+						},
+						"content_disposition": {
+							Optional: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceMavenHostedRepositoryStateUpgradeV0 sets the attributes version 1
+// added. format and type are the same for all maven hosted repositories, the
+// flags keep their defaults. purge_on_destroy and timeouts stay unset.
+func resourceMavenHostedRepositoryStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+	rawState["format"] = repository.RepositoryFormatMaven2
+	rawState["type"] = repository.RepositoryTypeHosted
+	for _, key := range []string{"deletion_protection", "adopt_existing"} {
+		if _, ok := rawState[key]; !ok {
+			rawState[key] = false
+		}
+	}
+	return rawState, nil
+}
+
 func getMavenHostedRepositoryFromResourceData(resourceData *schema.ResourceData) repository.MavenHostedRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	writePolicy := repository.StorageWritePolicy(storageConfig["write_policy"].(string))
@@ -109,7 +244,7 @@ func setMavenHostedRepositoryToResourceData(repo *repository.MavenHostedReposito
 	}
 
 	if repo.Cleanup != nil {
-		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
 			return err
 		}
 	}
@@ -124,24 +259,24 @@ func setMavenHostedRepositoryToResourceData(repo *repository.MavenHostedReposito
 }
 
 func resourceMavenHostedRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getMavenHostedRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Maven.Hosted.Create(repo); err != nil {
-		return err
+		return proprietaryComponentsError(resourceData, fmt.Errorf("creating maven hosted repository %q: %w", repo.Name, err))
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceMavenHostedRepositoryRead(resourceData, m)
+	return readRepositoryAfterCreate(resourceData, m, resourceMavenHostedRepositoryRead)
 }
 
 func resourceMavenHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Maven.Hosted.Get(resourceData.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading maven hosted repository %q: %w", resourceData.Id(), err)
 	}
 
 	if repo == nil {
@@ -149,29 +284,34 @@ func resourceMavenHostedRepositoryRead(resourceData *schema.ResourceData, m inte
 		return nil
 	}
 
+	setRepositoryFormat(resourceData, repository.RepositoryFormatMaven2, repository.RepositoryTypeHosted)
 	return setMavenHostedRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceMavenHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := resourceData.Id()
 	repo := getMavenHostedRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Maven.Hosted.Update(repoName, repo); err != nil {
-		return err
+		return proprietaryComponentsError(resourceData, fmt.Errorf("updating maven hosted repository %q: %w", repoName, err))
 	}
 
 	return resourceMavenHostedRepositoryRead(resourceData, m)
 }
 
 func resourceMavenHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Maven.Hosted.Delete(resourceData.Id())
+	client := m.(*api.Client)
+
+	if err := client.Repository.Maven.Hosted.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting maven hosted repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
 }
 
 func resourceMavenHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Maven.Hosted.Get(resourceData.Id())
 	return repo != nil, err
@@ -2,15 +2,25 @@ package repository_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceRepositoryMavenHosted() repository.MavenHostedRepository {
@@ -93,3 +103,634 @@ func TestAccResourceRepositoryMavenHosted(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryMavenHostedSnapshot(t *testing.T) {
+	writePolicy := repository.StorageWritePolicyAllow
+	versionPolicy := repository.MavenVersionPolicySnapshot
+	layoutPolicy := repository.MavenLayoutPolicyStrict
+	repo := repository.MavenHostedRepository{
+		Name:   fmt.Sprintf("maven-snapshots-%s", acctest.RandString(10)),
+		Online: true,
+		Maven: repository.Maven{
+			VersionPolicy: &versionPolicy,
+			LayoutPolicy:  &layoutPolicy,
+		},
+		Storage: repository.HostedStorage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: false,
+			WritePolicy:                 &writePolicy,
+		},
+	}
+	resourceName := "nexus_repository_maven_hosted.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryMavenHostedConfig(repo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.write_policy", string(*repo.Storage.WritePolicy)),
+					resource.TestCheckResourceAttr(resourceName, "maven.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maven.0.version_policy", string(*repo.Maven.VersionPolicy)),
+					resource.TestCheckResourceAttr(resourceName, "maven.0.layout_policy", string(*repo.Maven.LayoutPolicy)),
+				),
+			},
+			{
+				Config:             testAccResourceRepositoryMavenHostedConfig(repo),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     repo.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckRepositoryMavenHostedUpload uploads a pom to the repository and
+// checks whether Nexus accepted it
+func testAccCheckRepositoryMavenHostedUpload(name string, version string, allowed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*api.Client)
+		path := fmt.Sprintf("org/example/app/%[1]s/app-%[1]s.pom", version)
+		pom := fmt.Sprintf("<project><modelVersion>4.0.0</modelVersion><groupId>org.example</groupId><artifactId>app</artifactId><version>%s</version></project>", version)
+
+		err := api.NewRepositoryContentService(client).Upload(name, path, []byte(pom))
+		if allowed && err != nil {
+			return err
+		}
+		if !allowed && err == nil {
+			return fmt.Errorf("expected repository %s to reject the upload of %s", name, path)
+		}
+		return nil
+	}
+}
+
+func TestAccResourceRepositoryMavenHostedReadOnly(t *testing.T) {
+	repo := testAccResourceRepositoryMavenHosted()
+	readOnly := repository.StorageWritePolicyAllowDeny
+	readOnlyRepo := repo
+	readOnlyRepo.Storage.WritePolicy = &readOnly
+	resourceName := "nexus_repository_maven_hosted.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryMavenHostedConfig(repo),
+				Check:  testAccCheckRepositoryMavenHostedUpload(repo.Name, "1.0.0", true),
+			},
+			{
+				// Freezing the repository keeps it online but rejects writes
+				Config: testAccResourceRepositoryMavenHostedConfig(readOnlyRepo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "online", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.write_policy", string(readOnly)),
+					testAccCheckRepositoryMavenHostedUpload(repo.Name, "1.0.1", false),
+				),
+			},
+			{
+				Config: testAccResourceRepositoryMavenHostedConfig(repo),
+				Check:  testAccCheckRepositoryMavenHostedUpload(repo.Name, "1.0.2", true),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryMavenHostedErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, "internal error")
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "maven-internal",
+		"maven": []interface{}{
+			map[string]interface{}{
+				"version_policy": "RELEASE",
+			},
+		},
+		"storage": []interface{}{
+			map[string]interface{}{
+				"blob_store_name": "default",
+			},
+		},
+	})
+
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.Regexp(t, `^creating maven hosted repository "maven-internal": .*internal error$`, diags[0].Summary)
+
+	resourceData.SetId("maven-internal")
+	diags = res.ReadContext(context.Background(), resourceData, nexusClient)
+	assert.Regexp(t, `^reading maven hosted repository "maven-internal": .*internal error$`, diags[0].Summary)
+
+	diags = res.UpdateContext(context.Background(), resourceData, nexusClient)
+	assert.Regexp(t, `^updating maven hosted repository "maven-internal": .*internal error$`, diags[0].Summary)
+
+	diags = res.DeleteContext(context.Background(), resourceData, nexusClient)
+	assert.Regexp(t, `^deleting maven hosted repository "maven-internal": .*internal error$`, diags[0].Summary)
+}
+
+func TestResourceRepositoryMavenHostedNameForceNew(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	state := &terraform.InstanceState{
+		ID: "maven-releases",
+		Attributes: map[string]string{
+			"id":     "maven-releases",
+			"name":   "maven-releases",
+			"online": "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "maven-releases-renamed",
+		"online": true,
+	})
+
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["name"].RequiresNew)
+}
+
+const testAccResourceRepositoryMavenHostedImportConfig = `
+resource "nexus_repository_maven_hosted" "imported" {
+	name = "maven-releases"
+
+	storage {
+		blob_store_name = "default"
+		write_policy    = "ALLOW_ONCE"
+	}
+
+	maven {
+		version_policy = "RELEASE"
+	}
+}`
+
+// testAccCheckRepositoryMavenHostedImportPlan fails if the imported state
+// differs from config, i.e. if a plan after the import would not be empty
+func testAccCheckRepositoryMavenHostedImportPlan(config map[string]interface{}) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported state, got %d", len(states))
+		}
+
+		res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+		diff, err := res.Diff(context.Background(), states[0], terraform.NewResourceConfigRaw(config), acceptance.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		if !diff.Empty() {
+			return fmt.Errorf("expected an empty plan after import, got %v", diff.Attributes)
+		}
+		return nil
+	}
+}
+
+func TestAccResourceRepositoryMavenHostedImportDefaults(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccResourceRepositoryMavenHostedImportConfig,
+				ResourceName:  "nexus_repository_maven_hosted.imported",
+				ImportStateId: "maven-releases",
+				ImportState:   true,
+				ImportStateCheck: testAccCheckRepositoryMavenHostedImportPlan(map[string]interface{}{
+					"name":    "maven-releases",
+					"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "write_policy": "ALLOW_ONCE"}},
+					"maven":   []interface{}{map[string]interface{}{"version_policy": "RELEASE"}},
+				}),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryMavenHostedImportDefaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"maven-releases","format":"maven2","type":"hosted"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/maven/hosted/maven-releases":
+			fmt.Fprint(w, `{"name":"maven-releases","online":true,"storage":{"blobStoreName":"default","strictContentTypeValidation":false,"writePolicy":"ALLOW_ONCE"},"cleanup":{"policyNames":[]},"component":{"proprietaryComponents":false},"maven":{"versionPolicy":"RELEASE","layoutPolicy":"STRICT","contentDisposition":"INLINE"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	resourceData := res.Data(nil)
+	resourceData.SetId("maven-releases")
+
+	imported, err := res.Importer.StateContext(context.Background(), resourceData, nexusClient)
+	assert.NoError(t, err)
+	diags := res.ReadContext(context.Background(), imported[0], nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, imported[0].Get("cleanup"))
+
+	diff, err := res.Diff(context.Background(), imported[0].State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "maven-releases",
+		"storage": []interface{}{map[string]interface{}{
+			"blob_store_name":                "default",
+			"strict_content_type_validation": false,
+			"write_policy":                   "ALLOW_ONCE",
+		}},
+		"maven": []interface{}{map[string]interface{}{"version_policy": "RELEASE"}},
+	}), nexusClient)
+	assert.NoError(t, err)
+	assert.True(t, diff.Empty(), "%v", diff)
+}
+
+func TestResourceRepositoryMavenHostedReadAfterCreate(t *testing.T) {
+	reads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/maven/hosted":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/maven/hosted/maven-releases":
+			reads++
+			// The node behind the load balancer does not know the repository yet
+			if reads == 1 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, `{"name":"maven-releases","online":true,"storage":{"blobStoreName":"default","strictContentTypeValidation":true,"writePolicy":"ALLOW"},"maven":{"versionPolicy":"RELEASE","layoutPolicy":"STRICT"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":    "maven-releases",
+		"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "write_policy": "ALLOW"}},
+		"maven":   []interface{}{map[string]interface{}{"version_policy": "RELEASE", "layout_policy": "STRICT"}},
+	})
+
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 2, reads)
+	assert.Equal(t, "maven-releases", resourceData.Id())
+	assert.Equal(t, "RELEASE", resourceData.Get("maven.0.version_policy"))
+}
+
+func TestResourceRepositoryMavenHostedPurgeOnDestroy(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/components":
+			assert.Equal(t, "maven-releases", r.URL.Query().Get("repository"))
+			if r.URL.Query().Get("continuationToken") == "" {
+				fmt.Fprint(w, `{"items":[{"id":"component-1"},{"id":"component-2"}],"continuationToken":"next"}`)
+				return
+			}
+			fmt.Fprint(w, `{"items":[{"id":"component-3"}],"continuationToken":null}`)
+		case r.Method == http.MethodDelete:
+			calls = append(calls, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":             "maven-releases",
+		"purge_on_destroy": true,
+	})
+	resourceData.SetId("maven-releases")
+
+	diags := res.DeleteContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{
+		"/service/rest/v1/components/component-1",
+		"/service/rest/v1/components/component-2",
+		"/service/rest/v1/components/component-3",
+		"/service/rest/v1/repositories/maven-releases",
+	}, calls)
+}
+
+func TestResourceRepositoryMavenHostedDeletionProtection(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":                "maven-releases",
+		"deletion_protection": true,
+		"purge_on_destroy":    true,
+	})
+	resourceData.SetId("maven-releases")
+
+	diags := res.DeleteContext(context.Background(), resourceData, nexusClient)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Error, diags[0].Severity)
+		assert.Equal(t, `repository "maven-releases" is protected against deletion, set deletion_protection to false and apply before destroying it`, diags[0].Summary)
+	}
+	assert.Empty(t, calls)
+
+	resourceData.Set("deletion_protection", false)
+	resourceData.Set("purge_on_destroy", false)
+	diags = res.DeleteContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{"DELETE /service/rest/v1/repositories/maven-releases"}, calls)
+}
+
+func TestResourceRepositoryMavenHostedImportFormatMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories" {
+			fmt.Fprint(w, `[{"name":"maven-releases","format":"maven2","type":"hosted"},{"name":"maven-central","format":"maven2","type":"proxy"},{"name":"npm-private","format":"npm","type":"hosted"}]`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	importRepository := func(id string) error {
+		resourceData := res.Data(nil)
+		resourceData.SetId(id)
+		_, err := res.Importer.StateContext(context.Background(), resourceData, nexusClient)
+		return err
+	}
+
+	assert.NoError(t, importRepository("maven-releases"))
+	assert.EqualError(t, importRepository("npm-private"), `importing repository "npm-private": repository is a npm hosted repository, expected a maven2 hosted repository`)
+	assert.EqualError(t, importRepository("maven-central"), `importing repository "maven-central": repository is a maven2 proxy repository, expected a maven2 hosted repository`)
+	assert.EqualError(t, importRepository("missing"), `importing repository "missing": repository not found`)
+}
+
+func TestResourceRepositoryMavenHostedPolicyValidation(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	validate := func(maven map[string]interface{}) diag.Diagnostics {
+		return res.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":    "maven-releases",
+			"online":  true,
+			"maven":   []interface{}{maven},
+			"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+		}))
+	}
+
+	assert.False(t, validate(map[string]interface{}{"version_policy": "RELEASE", "layout_policy": "STRICT", "content_disposition": "ATTACHMENT"}).HasError())
+	assert.False(t, validate(map[string]interface{}{"version_policy": "SNAPSHOT", "layout_policy": "PERMISSIVE", "content_disposition": "INLINE"}).HasError())
+
+	diags := validate(map[string]interface{}{"version_policy": "SNAPSHOT", "layout_policy": "LAX"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "expected maven.0.layout_policy to be one of")
+
+	diags = validate(map[string]interface{}{"version_policy": "RELEASE", "layout_policy": "STRICT", "content_disposition": "attachment"})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "expected maven.0.content_disposition to be one of")
+}
+
+func TestResourceRepositoryMavenHostedStateUpgradeV0(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	assert.Equal(t, 1, res.SchemaVersion)
+	if !assert.Len(t, res.StateUpgraders, 1) {
+		return
+	}
+	upgrader := res.StateUpgraders[0]
+	assert.Equal(t, 0, upgrader.Version)
+	// Version 0 states lack the attributes added since
+	assert.False(t, upgrader.Type.Equals(res.CoreConfigSchema().ImpliedType()))
+	assert.False(t, upgrader.Type.HasAttribute("format"))
+	assert.False(t, upgrader.Type.HasAttribute("purge_on_destroy"))
+	assert.False(t, upgrader.Type.HasAttribute("timeouts"))
+
+	rawState := map[string]interface{}{
+		"id":     "maven-releases",
+		"name":   "maven-releases",
+		"online": true,
+		"storage": []interface{}{map[string]interface{}{
+			"blob_store_name":                "default",
+			"strict_content_type_validation": true,
+			"write_policy":                   "ALLOW_ONCE",
+		}},
+		"cleanup": []interface{}{map[string]interface{}{
+			"policy_names": []interface{}{"cleanup-weekly"},
+		}},
+		"maven": []interface{}{map[string]interface{}{
+			"version_policy": "RELEASE",
+			"layout_policy":  "STRICT",
+		}},
+	}
+
+	upgraded, err := upgrader.Upgrade(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+
+	value, err := schema.JSONMapToStateValue(upgraded, res.CoreConfigSchema())
+	if assert.NoError(t, err) {
+		assert.Equal(t, "maven-releases", value.GetAttr("name").AsString())
+		assert.Equal(t, 1, value.GetAttr("storage").LengthInt())
+		assert.Equal(t, "maven2", value.GetAttr("format").AsString())
+		assert.Equal(t, "hosted", value.GetAttr("type").AsString())
+		assert.True(t, value.GetAttr("deletion_protection").False())
+		assert.True(t, value.GetAttr("adopt_existing").False())
+	}
+}
+
+func TestResourceRepositoryMavenHostedAdoptExisting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/maven/hosted":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `[{"id":"name","message":"Repository of same name exists"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"maven-releases","format":"maven2","type":"hosted"},{"name":"npm-private","format":"npm","type":"hosted"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/maven/hosted/maven-releases":
+			fmt.Fprint(w, `{"name":"maven-releases","online":true,"storage":{"blobStoreName":"releases","strictContentTypeValidation":true,"writePolicy":"ALLOW_ONCE"},"maven":{"versionPolicy":"RELEASE","layoutPolicy":"STRICT"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	create := func(name string, adoptExisting bool) (*schema.ResourceData, diag.Diagnostics) {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":           name,
+			"adopt_existing": adoptExisting,
+			"maven":          []interface{}{map[string]interface{}{"version_policy": "RELEASE", "layout_policy": "STRICT"}},
+			"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default", "write_policy": "ALLOW"}},
+		})
+		return resourceData, res.CreateContext(context.Background(), resourceData, nexusClient)
+	}
+
+	resourceData, diags := create("maven-releases", false)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "Repository of same name exists")
+	assert.Empty(t, resourceData.Id())
+
+	// The existing repository is read as is, the next plan shows the differences
+	resourceData, diags = create("maven-releases", true)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "maven-releases", resourceData.Id())
+	assert.Equal(t, "releases", resourceData.Get("storage.0.blob_store_name"))
+	assert.Equal(t, "ALLOW_ONCE", resourceData.Get("storage.0.write_policy"))
+
+	// A repository of another format is never adopted
+	resourceData, diags = create("npm-private", true)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "repository is a npm hosted repository, expected a maven2 hosted repository")
+	assert.Empty(t, resourceData.Id())
+}
+
+func TestResourceRepositoryMavenHostedAdoptExistingOtherError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/maven/hosted":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, "database is locked")
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"maven-releases","format":"maven2","type":"hosted"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/maven/hosted/maven-releases":
+			fmt.Fprint(w, `{"name":"maven-releases","online":true,"storage":{"blobStoreName":"releases","strictContentTypeValidation":true,"writePolicy":"ALLOW_ONCE"},"maven":{"versionPolicy":"RELEASE","layoutPolicy":"STRICT"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":           "maven-releases",
+		"adopt_existing": true,
+		"maven":          []interface{}{map[string]interface{}{"version_policy": "RELEASE", "layout_policy": "STRICT"}},
+		"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default", "write_policy": "ALLOW"}},
+	})
+
+	// A failed create is not mistaken for a taken name, even though the
+	// repository exists
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "creating maven hosted repository \"maven-releases\": could not create repository 'maven-releases': HTTP: 500, database is locked", diags[0].Summary)
+	assert.Empty(t, resourceData.Id())
+}
+
+func TestAccResourceRepositoryMavenHostedProprietaryComponents(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	repo := testAccResourceRepositoryMavenHosted()
+	resourceName := "nexus_repository_maven_hosted.acceptance"
+	disabled := repo
+	disabled.Component = &repository.Component{
+		ProprietaryComponents: false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryMavenHostedConfig(repo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "component.0.proprietary_components", "true"),
+				),
+			},
+			{
+				Config: testAccResourceRepositoryMavenHostedConfig(disabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "component.0.proprietary_components", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryMavenHostedProprietaryComponentsUnsupported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `[{"id":"PARAMETER component","message":"Firewall is not licensed"}]`)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_hosted"]
+	config := func(proprietaryComponents bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":      "maven-internal",
+			"maven":     []interface{}{map[string]interface{}{"version_policy": "RELEASE"}},
+			"storage":   []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+			"component": []interface{}{map[string]interface{}{"proprietary_components": proprietaryComponents}},
+		}
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config(true))
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "Firewall is not licensed")
+	assert.Contains(t, diags[0].Summary, "component.proprietary_components requires Sonatype Nexus Firewall")
+
+	resourceData.SetId("maven-internal")
+	diags = res.UpdateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "component.proprietary_components requires Sonatype Nexus Firewall")
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, config(false))
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.NotContains(t, diags[0].Summary, "Sonatype Nexus Firewall")
+}
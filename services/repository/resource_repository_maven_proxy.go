@@ -0,0 +1,335 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryMavenProxy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a maven proxy repository.",
+
+		CreateContext: resourceMavenProxyRepositoryCreateContext,
+		DeleteContext: withContext(deleteWithProtection(resourceMavenProxyRepositoryDelete)),
+		Exists:        resourceMavenProxyRepositoryExists,
+		ReadContext:   withContext(resourceMavenProxyRepositoryRead),
+		UpdateContext: resourceMavenProxyRepositoryUpdateContext,
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: validateHTTPClientAuthentication,
+		Importer: &schema.ResourceImporter{
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatMaven2, repository.RepositoryTypeProxy),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
+			// Proxy schemas
+			"cleanup":                 repositorySchema.ResourceCleanup,
+			"http_client":             repositorySchema.ResourceHTTPClientWithPreemptiveAuth,
+			"negative_cache":          repositorySchema.ResourceNegativeCache,
+			"prime_paths":             repositorySchema.ResourcePrimePaths,
+			"prime_strict":            repositorySchema.ResourcePrimeStrict,
+			"verify_remote_on_create": repositorySchema.ResourceVerifyRemoteOnCreate,
+			"proxy":                   repositorySchema.ResourceProxy,
+			"routing_rule":            repositorySchema.ResourceRoutingRule,
+			"storage":                 repositorySchema.ResourceStorage,
+			// Maven proxy schemas
+			"maven": repositorySchema.ResourceMaven,
+		},
+	}
+}
+
+// Remotes which are known to serve either releases or snapshots only
+var mavenReleaseOnlyHosts = []string{
+	"repo.maven.apache.org",
+	"repo1.maven.org",
+}
+
+// mavenRemoteVersionPolicy guesses the version policy a remote maven
+// repository enforces from its URL. It returns an empty string if the remote
+// may serve releases and snapshots.
+func mavenRemoteVersionPolicy(remoteURL string) repository.MavenVersionPolicy {
+	remote, err := url.Parse(remoteURL)
+	if err != nil {
+		return ""
+	}
+	for _, host := range mavenReleaseOnlyHosts {
+		if strings.EqualFold(remote.Hostname(), host) {
+			return repository.MavenVersionPolicyRelease
+		}
+	}
+	path := strings.ToLower(remote.Path)
+	switch {
+	case strings.Contains(path, "snapshot"):
+		return repository.MavenVersionPolicySnapshot
+	case strings.Contains(path, "release"):
+		return repository.MavenVersionPolicyRelease
+	}
+	return ""
+}
+
+// mavenProxyVersionPolicyWarnings warns about a MIXED proxy of a remote which
+// strictly separates releases and snapshots. Nexus does not allow to change
+// the version policy later on, so this is only checked on create.
+func mavenProxyVersionPolicyWarnings(resourceData *schema.ResourceData) diag.Diagnostics {
+	if resourceData.Get("maven.0.version_policy").(string) != string(repository.MavenVersionPolicyMixed) {
+		return nil
+	}
+	remoteURL := resourceData.Get("proxy.0.remote_url").(string)
+	remotePolicy := mavenRemoteVersionPolicy(remoteURL)
+	if remotePolicy == "" {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("maven proxy repository %q uses version_policy %q", resourceData.Get("name").(string), repository.MavenVersionPolicyMixed),
+		Detail: fmt.Sprintf("The remote %s only serves %s artifacts. Use version_policy %q and a separate proxy repository for the other artifacts, e.g. combined in a group repository.",
+			remoteURL, strings.ToLower(string(remotePolicy)), remotePolicy),
+	}}
+}
+
+// mavenProxyMetadataMaxAgeWarnings warns about a proxy which never caches
+// metadata. Maven clients request maven-metadata.xml and the archetype
+// catalog for nearly every build, and each of these requests would hit the
+// remote.
+func mavenProxyMetadataMaxAgeWarnings(resourceData *schema.ResourceData) diag.Diagnostics {
+	if resourceData.Get("proxy.0.metadata_max_age").(int) != 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("maven proxy repository %q never caches metadata", resourceData.Get("name").(string)),
+		Detail:   "With metadata_max_age 0 Nexus fetches maven-metadata.xml and the archetype catalog from the remote on every request, which slows down builds and puts load on the remote. Cache the metadata for a few minutes at least, or use -1 to cache it forever.",
+	}}
+}
+
+func resourceMavenProxyRepositoryCreateContext(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	warnings := append(mavenProxyVersionPolicyWarnings(resourceData), mavenProxyMetadataMaxAgeWarnings(resourceData)...)
+
+	diags := createWithCachePriming(createWithAdoption(repository.RepositoryFormatMaven2, repository.RepositoryTypeProxy, createWithBlobStoreRetry(resourceMavenProxyRepositoryCreate), resourceMavenProxyRepositoryRead))(ctx, resourceData, m)
+	if diags.HasError() {
+		return diags
+	}
+	return append(append(diags, warnings...), proxyTrustStoreWarnings(resourceData, m)...)
+}
+
+func resourceMavenProxyRepositoryUpdateContext(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	warnings := mavenProxyMetadataMaxAgeWarnings(resourceData)
+
+	diags := withContext(resourceMavenProxyRepositoryUpdate)(ctx, resourceData, m)
+	if diags.HasError() {
+		return diags
+	}
+	if resourceData.HasChanges("proxy.0.remote_url", "http_client.0.connection.0.use_trust_store") {
+		warnings = append(warnings, proxyTrustStoreWarnings(resourceData, m)...)
+	}
+	return append(diags, warnings...)
+}
+
+func getMavenProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.MavenProxyRepository {
+	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
+	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+
+	repo := repository.MavenProxyRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.Storage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+		},
+		HTTPClient: repository.HTTPClientWithPreemptiveAuth{
+			AutoBlock: httpClientConfig["auto_block"].(bool),
+			Blocked:   httpClientConfig["blocked"].(bool),
+		},
+		NegativeCache: getNegativeCacheFromResourceData(resourceData),
+		Proxy: repository.Proxy{
+			ContentMaxAge:  proxyConfig["content_max_age"].(int),
+			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
+			RemoteURL:      proxyConfig["remote_url"].(string),
+		},
+		Maven: repository.Maven{},
+	}
+
+	mavenList := resourceData.Get("maven").([]interface{})
+	if len(mavenList) > 0 && mavenList[0] != nil {
+		mavenConfig := mavenList[0].(map[string]interface{})
+		if mavenConfig["version_policy"] != "" {
+			versionPolicy := repository.MavenVersionPolicy(mavenConfig["version_policy"].(string))
+			repo.Maven.VersionPolicy = &versionPolicy
+		}
+		if mavenConfig["layout_policy"] != "" {
+			layoutPolicy := repository.MavenLayoutPolicy(mavenConfig["layout_policy"].(string))
+			repo.Maven.LayoutPolicy = &layoutPolicy
+		}
+		if mavenConfig["content_disposition"] != "" {
+			contentDisposition := repository.MavenContentDisposition(mavenConfig["content_disposition"].(string))
+			repo.Maven.ContentDisposition = &contentDisposition
+		}
+	}
+
+	if routingRule, ok := resourceData.GetOk("routing_rule"); ok {
+		repo.RoutingRule = tools.GetStringPointer(routingRule.(string))
+		repo.RoutingRuleName = tools.GetStringPointer(routingRule.(string))
+	}
+
+	cleanupList := resourceData.Get("cleanup").([]interface{})
+	if len(cleanupList) > 0 && cleanupList[0] != nil {
+		cleanupConfig := cleanupList[0].(map[string]interface{})
+		if len(cleanupConfig) > 0 {
+			policy_names, ok := cleanupConfig["policy_names"]
+			if ok {
+				repo.Cleanup = &repository.Cleanup{
+					PolicyNames: tools.InterfaceSliceToStringSlice(policy_names.(*schema.Set).List()),
+				}
+			}
+		}
+	}
+
+	if v, ok := httpClientConfig["authentication"]; ok {
+		authList := v.([]interface{})
+		if len(authList) == 1 && authList[0] != nil {
+			authConfig := authList[0].(map[string]interface{})
+
+			repo.HTTPClient.Authentication = &repository.HTTPClientAuthenticationWithPreemptive{
+				NTLMDomain: tools.GetStringPointer(authConfig["ntlm_domain"].(string)),
+				NTLMHost:   tools.GetStringPointer(authConfig["ntlm_host"].(string)),
+				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:   tools.GetStringPointer(authConfig["username"].(string)),
+				Password:   tools.GetStringPointer(authConfig["password"].(string)),
+				Preemptive: tools.GetBoolPointer(authConfig["preemptive"].(bool)),
+			}
+		}
+	}
+
+	if v, ok := httpClientConfig["connection"]; ok {
+		connectionList := v.([]interface{})
+		if len(connectionList) == 1 && connectionList[0] != nil {
+			connectionConfig := connectionList[0].(map[string]interface{})
+			repo.HTTPClient.Connection = &repository.HTTPClientConnection{
+				EnableCircularRedirects: tools.GetBoolPointer(connectionConfig["enable_circular_redirects"].(bool)),
+				EnableCookies:           tools.GetBoolPointer(connectionConfig["enable_cookies"].(bool)),
+				Retries:                 tools.GetIntPointer(connectionConfig["retries"].(int)),
+				Timeout:                 tools.GetIntPointer(connectionConfig["timeout"].(int)),
+				UserAgentSuffix:         connectionConfig["user_agent_suffix"].(string),
+				UseTrustStore:           tools.GetBoolPointer(connectionConfig["use_trust_store"].(bool)),
+			}
+		}
+	}
+
+	return repo
+}
+
+func setMavenProxyRepositoryToResourceData(repo *repository.MavenProxyRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if repo.RoutingRuleName != nil {
+		resourceData.Set("routing_rule", repo.RoutingRuleName)
+	} else if repo.RoutingRule != nil {
+		resourceData.Set("routing_rule", repo.RoutingRule)
+	}
+
+	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("http_client", flattenHTTPClientWithPreemptiveAuth(&repo.HTTPClient, resourceData)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("negative_cache", flattenNegativeCache(normalizeNegativeCache(&repo.NegativeCache, resourceData))); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("proxy", flattenProxy(&repo.Proxy)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("maven", flattenMaven(&repo.Maven)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceMavenProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repo := getMavenProxyRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Maven.Proxy.Create(repo); err != nil {
+		return fmt.Errorf("creating maven proxy repository %q: %w", repo.Name, err)
+	}
+	resourceData.SetId(repo.Name)
+
+	return readRepositoryAfterCreate(resourceData, m, resourceMavenProxyRepositoryRead)
+}
+
+func resourceMavenProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repo, err := client.Repository.Maven.Proxy.Get(resourceData.Id())
+	if err != nil {
+		return fmt.Errorf("reading maven proxy repository %q: %w", resourceData.Id(), err)
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	setRepositoryFormat(resourceData, repository.RepositoryFormatMaven2, repository.RepositoryTypeProxy)
+	return setMavenProxyRepositoryToResourceData(repo, resourceData)
+}
+
+func resourceMavenProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repoName := resourceData.Id()
+	repo := getMavenProxyRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Maven.Proxy.Update(repoName, repo); err != nil {
+		return fmt.Errorf("updating maven proxy repository %q: %w", repoName, err)
+	}
+
+	return resourceMavenProxyRepositoryRead(resourceData, m)
+}
+
+func resourceMavenProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	if err := client.Repository.Maven.Proxy.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting maven proxy repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
+}
+
+func resourceMavenProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*api.Client)
+
+	repo, err := client.Repository.Maven.Proxy.Get(resourceData.Id())
+	return repo != nil, err
+}
@@ -0,0 +1,386 @@
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccResourceRepositoryMavenProxy() repository.MavenProxyRepository {
+	versionPolicy := repository.MavenVersionPolicyRelease
+	layoutPolicy := repository.MavenLayoutPolicyStrict
+	contentDisposition := repository.MavenContentDispositionAttachment
+
+	return repository.MavenProxyRepository{
+		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
+		Online: true,
+		Maven: repository.Maven{
+			VersionPolicy:      &versionPolicy,
+			LayoutPolicy:       &layoutPolicy,
+			ContentDisposition: &contentDisposition,
+		},
+		Storage: repository.Storage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: true,
+		},
+		Cleanup: &repository.Cleanup{
+			PolicyNames: []string{"cleanup-weekly"},
+		},
+		HTTPClient: repository.HTTPClientWithPreemptiveAuth{
+			AutoBlock: true,
+			Blocked:   false,
+		},
+		NegativeCache: repository.NegativeCache{
+			Enabled: true,
+			TTL:     5,
+		},
+		Proxy: repository.Proxy{
+			ContentMaxAge:  770,
+			MetadataMaxAge: 770,
+			RemoteURL:      "https://repo1.maven.org/maven2/",
+		},
+	}
+}
+
+func testAccResourceRepositoryMavenProxyConfig(repo repository.MavenProxyRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryMavenProxyTemplate := template.Must(template.New("MavenProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryMavenProxy))
+	if err := resourceRepositoryMavenProxyTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func testAccCheckRepositoryMavenProxyNegativeCache(name string, expected repository.NegativeCache) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*api.Client)
+		repo, err := client.Repository.Maven.Proxy.Get(name)
+		if err != nil {
+			return err
+		}
+		if repo == nil {
+			return fmt.Errorf("maven proxy repository %s not found", name)
+		}
+		if repo.NegativeCache != expected {
+			return fmt.Errorf("expected negative cache %+v on server, got %+v", expected, repo.NegativeCache)
+		}
+		return nil
+	}
+}
+
+func TestAccResourceRepositoryMavenProxy(t *testing.T) {
+	repo := testAccResourceRepositoryMavenProxy()
+	resourceName := "nexus_repository_maven_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryMavenProxyConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "http_client.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.auto_block", strconv.FormatBool(repo.HTTPClient.AutoBlock)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.blocked", strconv.FormatBool(repo.HTTPClient.Blocked)),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.0.enabled", strconv.FormatBool(repo.NegativeCache.Enabled)),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.0.ttl", strconv.Itoa(repo.NegativeCache.TTL)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.content_max_age", strconv.Itoa(repo.Proxy.ContentMaxAge)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.metadata_max_age", strconv.Itoa(repo.Proxy.MetadataMaxAge)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.remote_url", repo.Proxy.RemoteURL),
+						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.0", repo.Cleanup.PolicyNames[0]),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "maven.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "maven.0.version_policy", string(*repo.Maven.VersionPolicy)),
+						resource.TestCheckResourceAttr(resourceName, "maven.0.layout_policy", string(*repo.Maven.LayoutPolicy)),
+						resource.TestCheckResourceAttr(resourceName, "maven.0.content_disposition", string(*repo.Maven.ContentDisposition)),
+					),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     repo.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceRepositoryMavenProxyNegativeCacheDisabled(t *testing.T) {
+	repo := testAccResourceRepositoryMavenProxy()
+	repo.NegativeCache = repository.NegativeCache{
+		Enabled: false,
+		TTL:     60,
+	}
+	resourceName := "nexus_repository_maven_proxy.acceptance"
+
+	enabledRepo := repo
+	enabledRepo.NegativeCache = repository.NegativeCache{
+		Enabled: true,
+		TTL:     60,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryMavenProxyConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "negative_cache.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "negative_cache.0.ttl", "60"),
+					testAccCheckRepositoryMavenProxyNegativeCache(repo.Name, repo.NegativeCache),
+				),
+			},
+			{
+				// Toggle negative caching on and off again to make sure false is sent on update as well
+				Config: testAccResourceRepositoryMavenProxyConfig(enabledRepo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "negative_cache.0.enabled", "true"),
+					testAccCheckRepositoryMavenProxyNegativeCache(repo.Name, enabledRepo.NegativeCache),
+				),
+			},
+			{
+				Config: testAccResourceRepositoryMavenProxyConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "negative_cache.0.enabled", "false"),
+					testAccCheckRepositoryMavenProxyNegativeCache(repo.Name, repo.NegativeCache),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryMavenProxyHTTPClientAuthentication(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_proxy"]
+	config := func(authentication map[string]interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":           "maven-central",
+			"online":         true,
+			"http_client":    []interface{}{map[string]interface{}{"authentication": []interface{}{authentication}}},
+			"maven":          []interface{}{map[string]interface{}{"version_policy": "RELEASE", "layout_policy": "STRICT"}},
+			"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+			"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://repo1.maven.org/maven2/"}},
+			"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+		})
+	}
+
+	for _, authentication := range []map[string]interface{}{
+		{"type": "ntlm", "username": "user", "password": "secret", "ntlm_domain": "EXAMPLE", "ntlm_host": "nexus.example.com"},
+		{"type": "username", "username": "user", "password": "secret"},
+	} {
+		_, err := res.Diff(context.Background(), nil, config(authentication), nil)
+		assert.NoError(t, err)
+	}
+
+	for _, testCase := range []struct {
+		authentication map[string]interface{}
+		expectedErr    string
+	}{
+		{
+			authentication: map[string]interface{}{"type": "ntlm", "username": "user", "password": "secret", "ntlm_host": "nexus.example.com"},
+			expectedErr:    `http_client.0.authentication.0.ntlm_domain is required when http_client.0.authentication.0.type is "ntlm"`,
+		},
+		{
+			authentication: map[string]interface{}{"type": "ntlm", "username": "user", "password": "secret", "ntlm_domain": "EXAMPLE"},
+			expectedErr:    `http_client.0.authentication.0.ntlm_host is required when http_client.0.authentication.0.type is "ntlm"`,
+		},
+		{
+			authentication: map[string]interface{}{"type": "username", "password": "secret"},
+			expectedErr:    `http_client.0.authentication.0.username is required when http_client.0.authentication.0.type is "username"`,
+		},
+		{
+			authentication: map[string]interface{}{"type": "username", "username": "user"},
+			expectedErr:    `http_client.0.authentication.0.password is required when http_client.0.authentication.0.type is "username"`,
+		},
+	} {
+		_, err := res.Diff(context.Background(), nil, config(testCase.authentication), nil)
+		assert.EqualError(t, err, testCase.expectedErr)
+	}
+}
+
+func TestResourceRepositoryMavenProxyVersionPolicyValidation(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_proxy"]
+	validateVersionPolicy := res.Schema["maven"].Elem.(*schema.Resource).Schema["version_policy"].ValidateFunc
+
+	for _, versionPolicy := range []string{"RELEASE", "SNAPSHOT", "MIXED"} {
+		_, errs := validateVersionPolicy(versionPolicy, "maven.0.version_policy")
+		assert.Empty(t, errs, versionPolicy)
+	}
+
+	_, errs := validateVersionPolicy("mixed", "maven.0.version_policy")
+	assert.Len(t, errs, 1)
+}
+
+func TestResourceRepositoryMavenProxyVersionPolicyMixedWarning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/maven/proxy":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/service/rest/v1/repositories/maven/proxy/"):
+			fmt.Fprint(w, `{"name":"maven-proxy","online":true,"storage":{"blobStoreName":"default"},"proxy":{},"negativeCache":{},"httpClient":{},"maven":{"versionPolicy":"MIXED"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_proxy"]
+	create := func(remoteURL string, versionPolicy string) diag.Diagnostics {
+		return res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":           "maven-proxy",
+			"online":         true,
+			"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+			"maven":          []interface{}{map[string]interface{}{"version_policy": versionPolicy, "layout_policy": "STRICT"}},
+			"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+			"proxy":          []interface{}{map[string]interface{}{"remote_url": remoteURL}},
+			"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+		}), nexusClient)
+	}
+
+	diags := create("https://repo1.maven.org/maven2/", "MIXED")
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Detail, `only serves release artifacts. Use version_policy "RELEASE"`)
+
+	diags = create("https://oss.sonatype.org/content/repositories/snapshots/", "MIXED")
+	assert.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail, `only serves snapshot artifacts. Use version_policy "SNAPSHOT"`)
+
+	assert.Empty(t, create("https://repo1.maven.org/maven2/", "RELEASE"))
+	assert.Empty(t, create("https://maven.example.com/repository/public/", "MIXED"))
+}
+
+func TestResourceRepositoryMavenProxyMetadataMaxAgeWarning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/maven/proxy":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/service/rest/v1/repositories/maven/proxy/"):
+			fmt.Fprint(w, `{"name":"maven-proxy","online":true,"storage":{"blobStoreName":"default"},"proxy":{},"negativeCache":{},"httpClient":{},"maven":{"versionPolicy":"RELEASE"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_proxy"]
+	create := func(metadataMaxAge int) diag.Diagnostics {
+		return res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":           "maven-proxy",
+			"online":         true,
+			"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+			"maven":          []interface{}{map[string]interface{}{"version_policy": "RELEASE", "layout_policy": "STRICT"}},
+			"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+			"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://repo1.maven.org/maven2/", "metadata_max_age": metadataMaxAge}},
+			"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+		}), nexusClient)
+	}
+
+	diags := create(0)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, `maven proxy repository "maven-proxy" never caches metadata`, diags[0].Summary)
+
+	assert.Empty(t, create(1440))
+	assert.Empty(t, create(-1))
+}
+
+func TestResourceRepositoryMavenProxyTrustStoreWarning(t *testing.T) {
+	truststore := `[]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/maven/proxy":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/service/rest/v1/repositories/maven/proxy/"):
+			fmt.Fprint(w, `{"name":"maven-proxy","online":true,"storage":{"blobStoreName":"default"},"proxy":{"remoteUrl":"https://maven.example.com/releases/","metadataMaxAge":1440},"negativeCache":{},"httpClient":{"connection":{"useTrustStore":true}},"maven":{"versionPolicy":"RELEASE"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/ssl":
+			assert.Equal(t, "maven.example.com", r.URL.Query().Get("host"))
+			assert.Equal(t, "443", r.URL.Query().Get("port"))
+			fmt.Fprint(w, `{"subjectCommonName":"maven.example.com","issuerCommonName":"Example CA","fingerprint":"AB:CD:EF"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/ssl/truststore":
+			fmt.Fprint(w, truststore)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_maven_proxy"]
+	create := func() diag.Diagnostics {
+		return res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":   "maven-proxy",
+			"online": true,
+			"http_client": []interface{}{map[string]interface{}{
+				"auto_block": true,
+				"connection": []interface{}{map[string]interface{}{"use_trust_store": true}},
+			}},
+			"maven":          []interface{}{map[string]interface{}{"version_policy": "RELEASE", "layout_policy": "STRICT"}},
+			"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+			"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://maven.example.com/releases/", "metadata_max_age": 1440}},
+			"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+		}), nexusClient)
+	}
+
+	diags := create()
+	assert.False(t, diags.HasError())
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, `truststore of Nexus has no certificate for the remote of proxy repository "maven-proxy"`, diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "fingerprint AB:CD:EF")
+	}
+
+	// Either the certificate of the remote or of its issuer is enough
+	truststore = `[{"subjectCommonName":"maven.example.com","issuerCommonName":"Example CA","fingerprint":"ab:cd:ef"}]`
+	assert.Empty(t, create())
+	truststore = `[{"subjectCommonName":"Example CA","issuerCommonName":"Example CA","fingerprint":"12:34:56"}]`
+	assert.Empty(t, create())
+}
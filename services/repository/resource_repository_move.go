@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryMove() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to move the data of a repository of any format to another blob store.
+
+The move is started once when the resource is created by running the task "Admin - Change repository blob store". Destroying the resource removes the task from Nexus if it is still there.
+By default the resource waits for the task to finish. Use ` + "`timeouts`" + ` to give large repositories more time.`,
+
+		CreateContext: resourceRepositoryMoveCreate,
+		Read:          resourceRepositoryTaskRead,
+		Delete:        resourceRepositoryTaskRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "Name of the repository to move",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"target_blobstore": {
+				Description: "Name of the blob store to move the repository to",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"wait_for_completion": {
+				Default:     true,
+				Description: "Whether to wait until the move has finished. Otherwise the move continues in the background.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"task_id": repositoryTaskIDSchema,
+		},
+	}
+}
+
+func resourceRepositoryMoveCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client)
+	repositoryName := resourceData.Get("repository").(string)
+	targetBlobstore := resourceData.Get("target_blobstore").(string)
+
+	err := runRepositoryTask(ctx, resourceData, api.NewTaskService(client),
+		api.TaskTypeChangeRepositoryBlobStore,
+		fmt.Sprintf("Move repository %s to blob store %s", repositoryName, targetBlobstore),
+		map[string]string{
+			"repositoryName":      repositoryName,
+			"targetBlobStoreName": targetBlobstore,
+		},
+	)
+	if err != nil {
+		return diag.Errorf("moving repository %q to blob store %q: %v", repositoryName, targetBlobstore, err)
+	}
+	return nil
+}
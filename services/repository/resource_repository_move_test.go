@@ -0,0 +1,170 @@
+package repository_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTaskNexus simulates a single task of the given type and properties
+// which is running for the first polls and then finishes with the given result
+type fakeTaskNexus struct {
+	*httptest.Server
+
+	mutex     sync.Mutex
+	created   bool
+	removed   bool
+	runStatus int
+}
+
+// left returns whether the task has been created and not removed again
+func (f *fakeTaskNexus) left() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.created && !f.removed
+}
+
+func fakeTaskServer(t *testing.T, typeID string, properties map[string]string, runningPolls int, result string) *fakeTaskNexus {
+	f := &fakeTaskNexus{runStatus: http.StatusNoContent}
+	polls := 0
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/extdirect":
+			var request struct {
+				Action string            `json:"action"`
+				Method string            `json:"method"`
+				Data   []json.RawMessage `json:"data"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, "coreui_Task", request.Action)
+
+			var data interface{}
+			switch request.Method {
+			case "create":
+				var task struct {
+					TypeID     string            `json:"typeId"`
+					Schedule   string            `json:"schedule"`
+					Properties map[string]string `json:"properties"`
+				}
+				assert.NoError(t, json.Unmarshal(request.Data[0], &task))
+				assert.Equal(t, typeID, task.TypeID)
+				assert.Equal(t, "manual", task.Schedule)
+				assert.Equal(t, properties, task.Properties)
+				f.created = true
+				data = map[string]interface{}{"id": "task-1"}
+			case "remove":
+				f.removed = true
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"type":   "rpc",
+				"result": map[string]interface{}{"success": true, "data": data},
+			})
+		case f.removed:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/tasks/task-1/run":
+			w.WriteHeader(f.runStatus)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/tasks/task-1":
+			polls++
+			task := map[string]interface{}{"id": "task-1", "currentState": "RUNNING"}
+			if polls > runningPolls {
+				task["currentState"] = "WAITING"
+				task["lastRunResult"] = result
+				task["message"] = "Blob store s3 is read-only"
+			}
+			json.NewEncoder(w).Encode(task)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return f
+}
+
+func TestResourceRepositoryMove(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_move"]
+	config := map[string]interface{}{
+		"repository":       "maven-releases",
+		"target_blobstore": "s3",
+	}
+
+	properties := map[string]string{"repositoryName": "maven-releases", "targetBlobStoreName": "s3"}
+
+	server := fakeTaskServer(t, "repository.move", properties, 2, "OK")
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "task-1", resourceData.Id())
+	assert.Equal(t, "", resourceData.Get("task_id"))
+	assert.False(t, server.left())
+	assert.NoError(t, res.Delete(resourceData, nexusClient))
+
+	failingServer := fakeTaskServer(t, "repository.move", properties, 1, "FAILED")
+	defer failingServer.Close()
+	nexusClient = api.NewClient(client.Config{URL: failingServer.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, config)
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `moving repository "maven-releases" to blob store "s3": task task-1 failed: Blob store s3 is read-only`, diags[0].Summary)
+	// A failed task is kept for troubleshooting until the resource is destroyed
+	assert.Equal(t, "task-1", resourceData.Get("task_id"))
+	assert.True(t, failingServer.left())
+	assert.NoError(t, res.Delete(resourceData, nexusClient))
+	assert.False(t, failingServer.left())
+}
+
+func TestResourceRepositoryMoveWithoutWaiting(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_move"]
+	config := map[string]interface{}{
+		"repository":          "maven-releases",
+		"target_blobstore":    "s3",
+		"wait_for_completion": false,
+	}
+
+	server := fakeTaskServer(t, "repository.move", map[string]string{"repositoryName": "maven-releases", "targetBlobStoreName": "s3"}, 1, "OK")
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "task-1", resourceData.Get("task_id"))
+	assert.True(t, server.left())
+
+	assert.NoError(t, res.Delete(resourceData, nexusClient))
+	assert.False(t, server.left())
+}
+
+func TestResourceRepositoryMoveRunFails(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_move"]
+	config := map[string]interface{}{
+		"repository":       "maven-releases",
+		"target_blobstore": "s3",
+	}
+
+	server := fakeTaskServer(t, "repository.move", map[string]string{"repositoryName": "maven-releases", "targetBlobStoreName": "s3"}, 1, "OK")
+	server.runStatus = http.StatusMethodNotAllowed
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `moving repository "maven-releases" to blob store "s3": could not run task 'task-1': the task is disabled`, diags[0].Summary)
+	assert.Equal(t, "", resourceData.Id())
+	assert.False(t, server.left())
+}
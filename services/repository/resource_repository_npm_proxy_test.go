@@ -0,0 +1,278 @@
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"text/template"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccResourceRepositoryNpmProxy() repository.NpmProxyRepository {
+	return repository.NpmProxyRepository{
+		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
+		Online: true,
+		Storage: repository.Storage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: true,
+		},
+		Cleanup: &repository.Cleanup{
+			PolicyNames: []string{"cleanup-weekly"},
+		},
+		HTTPClient: repository.HTTPClient{
+			AutoBlock: true,
+			Blocked:   false,
+		},
+		NegativeCache: repository.NegativeCache{
+			Enabled: true,
+			TTL:     5,
+		},
+		Proxy: repository.Proxy{
+			ContentMaxAge:  770,
+			MetadataMaxAge: 770,
+			RemoteURL:      "https://registry.npmjs.org",
+		},
+	}
+}
+
+func testAccResourceRepositoryNpmProxyConfig(repo repository.NpmProxyRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryNpmProxyTemplate := template.Must(template.New("NpmProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryNpmProxy))
+	if err := resourceRepositoryNpmProxyTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryNpmProxy(t *testing.T) {
+	repo := testAccResourceRepositoryNpmProxy()
+	resourceName := "nexus_repository_npm_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryNpmProxyConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "http_client.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.auto_block", strconv.FormatBool(repo.HTTPClient.AutoBlock)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.blocked", strconv.FormatBool(repo.HTTPClient.Blocked)),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.0.enabled", strconv.FormatBool(repo.NegativeCache.Enabled)),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.0.ttl", strconv.Itoa(repo.NegativeCache.TTL)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.content_max_age", strconv.Itoa(repo.Proxy.ContentMaxAge)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.metadata_max_age", strconv.Itoa(repo.Proxy.MetadataMaxAge)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.remote_url", repo.Proxy.RemoteURL),
+						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.0", repo.Cleanup.PolicyNames[0]),
+					),
+					testAccCheckRepositoryFormatAndType(repo.Name, "npm", "proxy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     repo.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryNpmProxyNpmSettings(t *testing.T) {
+	var created repository.NpmProxyRepository
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/npm/proxy":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/npm/proxy/npmjs":
+			json.NewEncoder(w).Encode(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_npm_proxy"]
+	config := map[string]interface{}{
+		"name":           "npmjs",
+		"online":         true,
+		"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+		"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://registry.npmjs.org"}},
+		"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+		"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+		"npm":            []interface{}{map[string]interface{}{"remove_non_cataloged": true, "remove_quarantined": true}},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, &repository.Npm{RemoveNonCataloged: true, RemoveQuarantined: true}, created.Npm)
+	assert.Equal(t, "true", resourceData.State().Attributes["npm.0.remove_non_cataloged"])
+	assert.Equal(t, "true", resourceData.State().Attributes["npm.0.remove_quarantined"])
+
+	// Re-planning the same configuration against the read back state must not show changes
+	diff, err := res.Diff(context.Background(), resourceData.State(), terraform.NewResourceConfigRaw(config), nexusClient)
+	assert.NoError(t, err)
+	assert.True(t, diff.Empty(), "unexpected diff: %v", diff)
+}
+
+func TestAccResourceRepositoryNpmProxyFirewall(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	repo := testAccResourceRepositoryNpmProxy()
+	repo.Npm = &repository.Npm{
+		RemoveNonCataloged: true,
+		RemoveQuarantined:  true,
+	}
+	resourceName := "nexus_repository_npm_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryNpmProxyConfig(repo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "npm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "npm.0.remove_non_cataloged", "true"),
+					resource.TestCheckResourceAttr(resourceName, "npm.0.remove_quarantined", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryNpmProxyFirewallUnsupported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/npm/proxy" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `[{"id":"PARAMETER npm","message":"Firewall is not licensed"}]`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_npm_proxy"]
+	create := func(npm map[string]interface{}) diag.Diagnostics {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":           "npmjs",
+			"online":         true,
+			"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+			"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://registry.npmjs.org"}},
+			"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+			"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+			"npm":            []interface{}{npm},
+		})
+		return res.CreateContext(context.Background(), resourceData, nexusClient)
+	}
+
+	diags := create(map[string]interface{}{"remove_quarantined": true})
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "Firewall is not licensed")
+	assert.Contains(t, diags[0].Summary, "require Sonatype Nexus Firewall")
+
+	diags = create(map[string]interface{}{"remove_quarantined": false})
+	assert.True(t, diags.HasError())
+	assert.NotContains(t, diags[0].Summary, "require Sonatype Nexus Firewall")
+}
+
+func TestResourceRepositoryNpmProxyWithoutNegativeCache(t *testing.T) {
+	saved := repository.NpmProxyRepository{
+		Name:          "npmjs",
+		Online:        true,
+		Storage:       repository.Storage{BlobStoreName: "default", StrictContentTypeValidation: true},
+		HTTPClient:    repository.HTTPClient{AutoBlock: true},
+		NegativeCache: repository.NegativeCache{Enabled: false, TTL: 1440},
+		Proxy:         repository.Proxy{RemoteURL: "https://registry.npmjs.org", ContentMaxAge: 1440, MetadataMaxAge: 1440},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/npm/proxy":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPut && r.URL.Path == "/service/rest/v1/repositories/npm/proxy/npmjs":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/npm/proxy/npmjs":
+			json.NewEncoder(w).Encode(saved)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_npm_proxy"]
+
+	// An imported proxy with the default negative cache has no negative_cache block in state
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	resourceData.SetId("npmjs")
+	diags := res.ReadContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, resourceData.Get("negative_cache"))
+
+	saved.NegativeCache = repository.NegativeCache{}
+	diags = res.UpdateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, repository.NegativeCache{Enabled: false, TTL: 1440}, saved.NegativeCache)
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":        "npmjs",
+		"online":      true,
+		"http_client": []interface{}{map[string]interface{}{"auto_block": true}},
+		"proxy":       []interface{}{map[string]interface{}{"remote_url": "https://registry.npmjs.org"}},
+		"storage":     []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+	})
+	saved.NegativeCache = repository.NegativeCache{}
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, repository.NegativeCache{Enabled: false, TTL: 1440}, saved.NegativeCache)
+}
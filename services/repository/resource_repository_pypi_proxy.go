@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryPypiProxy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a pypi proxy repository.",
+
+		CreateContext: createWithCachePriming(createWithAdoption(repository.RepositoryFormatPyPi, repository.RepositoryTypeProxy, createWithBlobStoreRetry(resourcePypiProxyRepositoryCreate), resourcePypiProxyRepositoryRead)),
+		DeleteContext: withContext(deleteWithProtection(resourcePypiProxyRepositoryDelete)),
+		Exists:        resourcePypiProxyRepositoryExists,
+		ReadContext:   withContext(resourcePypiProxyRepositoryRead),
+		UpdateContext: withContext(resourcePypiProxyRepositoryUpdate),
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: validateHTTPClientAuthentication,
+		Importer: &schema.ResourceImporter{
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatPyPi, repository.RepositoryTypeProxy),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
+			// Proxy schemas
+			"cleanup":                 repositorySchema.ResourceCleanup,
+			"http_client":             repositorySchema.ResourceHTTPClient,
+			"negative_cache":          repositorySchema.ResourceNegativeCache,
+			"prime_paths":             repositorySchema.ResourcePrimePaths,
+			"prime_strict":            repositorySchema.ResourcePrimeStrict,
+			"verify_remote_on_create": repositorySchema.ResourceVerifyRemoteOnCreate,
+			"proxy":                   repositorySchema.ResourcePypiProxy,
+			"routing_rule":            repositorySchema.ResourceRoutingRule,
+			"storage":                 repositorySchema.ResourceStorage,
+		},
+	}
+}
+
+func getPypiProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.PypiProxyRepository {
+	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
+	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+
+	repo := repository.PypiProxyRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.Storage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+		},
+		HTTPClient: repository.HTTPClient{
+			AutoBlock: httpClientConfig["auto_block"].(bool),
+			Blocked:   httpClientConfig["blocked"].(bool),
+		},
+		NegativeCache: getNegativeCacheFromResourceData(resourceData),
+		Proxy: repository.Proxy{
+			ContentMaxAge:  proxyConfig["content_max_age"].(int),
+			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
+			RemoteURL:      repositorySchema.PypiIndexURL(proxyConfig["remote_url"].(string)),
+		},
+	}
+
+	if routingRule, ok := resourceData.GetOk("routing_rule"); ok {
+		repo.RoutingRule = tools.GetStringPointer(routingRule.(string))
+		repo.RoutingRuleName = tools.GetStringPointer(routingRule.(string))
+	}
+
+	cleanupList := resourceData.Get("cleanup").([]interface{})
+	if len(cleanupList) > 0 && cleanupList[0] != nil {
+		cleanupConfig := cleanupList[0].(map[string]interface{})
+		if len(cleanupConfig) > 0 {
+			policy_names, ok := cleanupConfig["policy_names"]
+			if ok {
+				repo.Cleanup = &repository.Cleanup{
+					PolicyNames: tools.InterfaceSliceToStringSlice(policy_names.(*schema.Set).List()),
+				}
+			}
+		}
+	}
+
+	if v, ok := httpClientConfig["authentication"]; ok {
+		authList := v.([]interface{})
+		if len(authList) == 1 && authList[0] != nil {
+			authConfig := authList[0].(map[string]interface{})
+
+			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
+				NTLMDomain: authConfig["ntlm_domain"].(string),
+				NTLMHost:   authConfig["ntlm_host"].(string),
+				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:   authConfig["username"].(string),
+				Password:   authConfig["password"].(string),
+			}
+		}
+	}
+
+	if v, ok := httpClientConfig["connection"]; ok {
+		connectionList := v.([]interface{})
+		if len(connectionList) == 1 && connectionList[0] != nil {
+			connectionConfig := connectionList[0].(map[string]interface{})
+			repo.HTTPClient.Connection = &repository.HTTPClientConnection{
+				EnableCircularRedirects: tools.GetBoolPointer(connectionConfig["enable_circular_redirects"].(bool)),
+				EnableCookies:           tools.GetBoolPointer(connectionConfig["enable_cookies"].(bool)),
+				Retries:                 tools.GetIntPointer(connectionConfig["retries"].(int)),
+				Timeout:                 tools.GetIntPointer(connectionConfig["timeout"].(int)),
+				UserAgentSuffix:         connectionConfig["user_agent_suffix"].(string),
+				UseTrustStore:           tools.GetBoolPointer(connectionConfig["use_trust_store"].(bool)),
+			}
+		}
+	}
+
+	return repo
+}
+
+func setPypiProxyRepositoryToResourceData(repo *repository.PypiProxyRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if repo.RoutingRuleName != nil {
+		resourceData.Set("routing_rule", repo.RoutingRuleName)
+	} else if repo.RoutingRule != nil {
+		resourceData.Set("routing_rule", repo.RoutingRule)
+	}
+
+	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("http_client", flattenHTTPClient(&repo.HTTPClient, resourceData)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("negative_cache", flattenNegativeCache(normalizeNegativeCache(&repo.NegativeCache, resourceData))); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("proxy", flattenProxy(&repo.Proxy)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourcePypiProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repo := getPypiProxyRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Pypi.Proxy.Create(repo); err != nil {
+		return fmt.Errorf("creating pypi proxy repository %q: %w", repo.Name, err)
+	}
+	resourceData.SetId(repo.Name)
+
+	return readRepositoryAfterCreate(resourceData, m, resourcePypiProxyRepositoryRead)
+}
+
+func resourcePypiProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repo, err := client.Repository.Pypi.Proxy.Get(resourceData.Id())
+	if err != nil {
+		return fmt.Errorf("reading pypi proxy repository %q: %w", resourceData.Id(), err)
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	setRepositoryFormat(resourceData, repository.RepositoryFormatPyPi, repository.RepositoryTypeProxy)
+	return setPypiProxyRepositoryToResourceData(repo, resourceData)
+}
+
+func resourcePypiProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repoName := resourceData.Id()
+	repo := getPypiProxyRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Pypi.Proxy.Update(repoName, repo); err != nil {
+		return fmt.Errorf("updating pypi proxy repository %q: %w", repoName, err)
+	}
+
+	return resourcePypiProxyRepositoryRead(resourceData, m)
+}
+
+func resourcePypiProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	if err := client.Repository.Pypi.Proxy.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting pypi proxy repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
+}
+
+func resourcePypiProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*api.Client)
+
+	repo, err := client.Repository.Pypi.Proxy.Get(resourceData.Id())
+	return repo != nil, err
+}
@@ -0,0 +1,157 @@
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"text/template"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccResourceRepositoryPypiProxy() repository.PypiProxyRepository {
+	return repository.PypiProxyRepository{
+		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
+		Online: true,
+		Storage: repository.Storage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: true,
+		},
+		HTTPClient: repository.HTTPClient{
+			AutoBlock: true,
+			Blocked:   false,
+		},
+		NegativeCache: repository.NegativeCache{
+			Enabled: true,
+			TTL:     5,
+		},
+		Proxy: repository.Proxy{
+			ContentMaxAge:  770,
+			MetadataMaxAge: 770,
+			RemoteURL:      "https://pypi.org/",
+		},
+	}
+}
+
+func testAccResourceRepositoryPypiProxyConfig(repo repository.PypiProxyRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryPypiProxyTemplate := template.Must(template.New("PypiProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryPypiProxy))
+	if err := resourceRepositoryPypiProxyTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryPypiProxy(t *testing.T) {
+	repo := testAccResourceRepositoryPypiProxy()
+	resourceName := "nexus_repository_pypi_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryPypiProxyConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "http_client.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.auto_block", strconv.FormatBool(repo.HTTPClient.AutoBlock)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.blocked", strconv.FormatBool(repo.HTTPClient.Blocked)),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.0.enabled", strconv.FormatBool(repo.NegativeCache.Enabled)),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.0.ttl", strconv.Itoa(repo.NegativeCache.TTL)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.content_max_age", strconv.Itoa(repo.Proxy.ContentMaxAge)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.metadata_max_age", strconv.Itoa(repo.Proxy.MetadataMaxAge)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.remote_url", repo.Proxy.RemoteURL),
+						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
+					),
+					testAccCheckRepositoryFormatAndType(repo.Name, "pypi", "proxy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     repo.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryPypiProxyIndexURL(t *testing.T) {
+	var saved repository.PypiProxyRepository
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/pypi/proxy":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/pypi/proxy/pypi-org":
+			json.NewEncoder(w).Encode(saved)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_pypi_proxy"]
+	config := func(remoteURL string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "pypi-org",
+			"online":      true,
+			"http_client": []interface{}{map[string]interface{}{"auto_block": true}},
+			"proxy":       []interface{}{map[string]interface{}{"remote_url": remoteURL}},
+			"storage":     []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+		}
+	}
+
+	// The simple index path is not sent, Nexus appends it itself
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config("https://pypi.org/simple/"))
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "https://pypi.org/", saved.Proxy.RemoteURL)
+	assert.Equal(t, repository.NegativeCache{Enabled: false, TTL: 1440}, saved.NegativeCache)
+
+	// The state holds the form Nexus returns, the config the other one
+	state := resourceData.State()
+	assert.Equal(t, "https://pypi.org/", state.Attributes["proxy.0.remote_url"])
+	for _, remoteURL := range []string{"https://pypi.org/simple/", "https://pypi.org/simple", "https://pypi.org"} {
+		diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config(remoteURL)), nexusClient)
+		assert.NoError(t, err)
+		if diff != nil {
+			assert.NotContains(t, diff.Attributes, "proxy.0.remote_url", remoteURL)
+		}
+	}
+
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config("https://mirror.example.com/simple/")), nexusClient)
+	assert.NoError(t, err)
+	assert.Contains(t, diff.Attributes, "proxy.0.remote_url")
+
+	diags = res.Validate(terraform.NewResourceConfigRaw(config("pypi.org/simple/")))
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryRawHosted() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a hosted raw repository.",
+
+		CreateContext: withContext(createWithAdoption(repository.RepositoryFormatRAW, repository.RepositoryTypeHosted, createWithBlobStoreRetry(resourceRawHostedRepositoryCreate), resourceRawHostedRepositoryRead)),
+		DeleteContext: withContext(deleteWithProtection(deleteWithComponentPurge(resourceRawHostedRepositoryDelete))),
+		Exists:        resourceRawHostedRepositoryExists,
+		ReadContext:   withContext(resourceRawHostedRepositoryRead),
+		UpdateContext: withContext(resourceRawHostedRepositoryUpdate),
+		Timeouts:      repositoryTimeouts(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatRAW, repository.RepositoryTypeHosted),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
+			"purge_on_destroy":    repositorySchema.ResourcePurgeOnDestroy,
+			// Hosted schemas
+			"cleanup":   repositorySchema.ResourceCleanup,
+			"component": repositorySchema.ResourceComponent,
+			"storage":   repositorySchema.ResourceHostedStorage,
+			// Raw hosted schemas
+			"raw": repositorySchema.ResourceRaw,
+		},
+	}
+}
+
+func getRawHostedRepositoryFromResourceData(resourceData *schema.ResourceData) repository.RawHostedRepository {
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+	writePolicy := repository.StorageWritePolicy(storageConfig["write_policy"].(string))
+
+	repo := repository.RawHostedRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.HostedStorage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+			WritePolicy:                 &writePolicy,
+		},
+	}
+
+	cleanupList := resourceData.Get("cleanup").([]interface{})
+	if len(cleanupList) > 0 && cleanupList[0] != nil {
+		cleanupConfig := cleanupList[0].(map[string]interface{})
+		if len(cleanupConfig) > 0 {
+			policy_names, ok := cleanupConfig["policy_names"]
+			if ok {
+				repo.Cleanup = &repository.Cleanup{
+					PolicyNames: tools.InterfaceSliceToStringSlice(policy_names.(*schema.Set).List()),
+				}
+			}
+		}
+	}
+
+	componentList := resourceData.Get("component").([]interface{})
+	if len(componentList) > 0 && componentList[0] != nil {
+		componentConfig := componentList[0].(map[string]interface{})
+		if len(componentConfig) > 0 {
+			repo.Component = &repository.Component{
+				ProprietaryComponents: componentConfig["proprietary_components"].(bool),
+			}
+		}
+	}
+
+	rawList := resourceData.Get("raw").([]interface{})
+	if len(rawList) > 0 && rawList[0] != nil {
+		rawConfig := rawList[0].(map[string]interface{})
+		if rawConfig["content_disposition"] != "" {
+			contentDisposition := repository.RawContentDisposition(rawConfig["content_disposition"].(string))
+			repo.Raw = &repository.Raw{
+				ContentDisposition: &contentDisposition,
+			}
+		}
+	}
+
+	return repo
+}
+
+func setRawHostedRepositoryToResourceData(repo *repository.RawHostedRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if err := resourceData.Set("storage", flattenHostedStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
+			return err
+		}
+	}
+
+	if repo.Component != nil {
+		if err := resourceData.Set("component", flattenComponent(repo.Component)); err != nil {
+			return err
+		}
+	}
+
+	if repo.Raw != nil {
+		if err := resourceData.Set("raw", flattenRaw(repo.Raw)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceRawHostedRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repo := getRawHostedRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Raw.Hosted.Create(repo); err != nil {
+		return fmt.Errorf("creating raw hosted repository %q: %w", repo.Name, err)
+	}
+	resourceData.SetId(repo.Name)
+
+	return readRepositoryAfterCreate(resourceData, m, resourceRawHostedRepositoryRead)
+}
+
+func resourceRawHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repo, err := client.Repository.Raw.Hosted.Get(resourceData.Id())
+	if err != nil {
+		return fmt.Errorf("reading raw hosted repository %q: %w", resourceData.Id(), err)
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	setRepositoryFormat(resourceData, repository.RepositoryFormatRAW, repository.RepositoryTypeHosted)
+	return setRawHostedRepositoryToResourceData(repo, resourceData)
+}
+
+func resourceRawHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repoName := resourceData.Id()
+	repo := getRawHostedRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Raw.Hosted.Update(repoName, repo); err != nil {
+		return fmt.Errorf("updating raw hosted repository %q: %w", repoName, err)
+	}
+
+	return resourceRawHostedRepositoryRead(resourceData, m)
+}
+
+func resourceRawHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	if err := client.Repository.Raw.Hosted.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting raw hosted repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
+}
+
+func resourceRawHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*api.Client)
+
+	repo, err := client.Repository.Raw.Hosted.Get(resourceData.Id())
+	return repo != nil, err
+}
@@ -0,0 +1,343 @@
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccResourceRepositoryRawHosted() repository.RawHostedRepository {
+	writePolicy := repository.StorageWritePolicyAllow
+	contentDisposition := repository.RawContentDispositionInline
+
+	return repository.RawHostedRepository{
+		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
+		Online: true,
+		Storage: repository.HostedStorage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: true,
+			WritePolicy:                 &writePolicy,
+		},
+		Cleanup: &repository.Cleanup{
+			PolicyNames: []string{"cleanup-weekly"},
+		},
+		Component: &repository.Component{
+			ProprietaryComponents: true,
+		},
+		Raw: &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		},
+	}
+}
+
+func testAccResourceRepositoryRawHostedConfig(repo repository.RawHostedRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryRawHostedTemplate := template.Must(template.New("RawHostedRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryRawHosted))
+	if err := resourceRepositoryRawHostedTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryRawHosted(t *testing.T) {
+	repo := testAccResourceRepositoryRawHosted()
+	resourceName := "nexus_repository_raw_hosted.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryRawHostedConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.write_policy", string(*repo.Storage.WritePolicy)),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.0", repo.Cleanup.PolicyNames[0]),
+						resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "component.0.proprietary_components", strconv.FormatBool(repo.Component.ProprietaryComponents)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "raw.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "raw.0.content_disposition", string(*repo.Raw.ContentDisposition)),
+					),
+					testAccCheckRepositoryFormatAndType(repo.Name, "raw", "hosted"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     repo.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryRawHostedCreateTimeout(t *testing.T) {
+	// Nexus is still starting and does not answer the create request in time
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_hosted"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "raw-internal",
+		"online":   true,
+		"storage":  []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+		"timeouts": map[string]interface{}{"create": "100ms"},
+	})
+
+	diff, err := res.Diff(context.Background(), nil, config, nexusClient)
+	assert.NoError(t, err)
+
+	start := time.Now()
+	_, diags := res.Apply(context.Background(), nil, diff, nexusClient)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Nexus did not respond in time", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "context deadline exceeded")
+}
+
+func TestResourceRepositoryRawHostedCreateTimeoutRetries(t *testing.T) {
+	// The blob store does not show up on the node which handles the create
+	// request, so the create is retried until the timeout expires
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `[{"id":"*","message":"Blob store default not found"}]`)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, server.Client())
+	api.SetRetries(nexusClient, 10)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_hosted"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "raw-internal",
+		"online":   true,
+		"storage":  []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+		"timeouts": map[string]interface{}{"create": "100ms"},
+	})
+
+	diff, err := res.Diff(context.Background(), nil, config, nexusClient)
+	assert.NoError(t, err)
+
+	start := time.Now()
+	_, diags := res.Apply(context.Background(), nil, diff, nexusClient)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Nexus did not respond in time", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "context deadline exceeded")
+}
+
+func TestResourceRepositoryRawHostedMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	var mutex sync.Mutex
+	created := map[string]json.RawMessage{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/raw/hosted":
+			var repo json.RawMessage
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&repo))
+			var name struct{ Name string }
+			assert.NoError(t, json.Unmarshal(repo, &name))
+			created[name.Name] = repo
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/service/rest/v1/repositories/raw/hosted/"):
+			repo, ok := created[strings.TrimPrefix(r.URL.Path, "/service/rest/v1/repositories/raw/hosted/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(repo)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	api.SetRequestLimit(nexusClient, api.NewRequestLimit(2))
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_hosted"]
+
+	// Terraform creates the repositories in parallel, each of them sends
+	// its requests through go-nexus-client
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"name":    fmt.Sprintf("raw-%d", i),
+			"online":  true,
+			"storage": []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+		})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+			assert.False(t, diags.HasError(), "%v", diags)
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, created, 8)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+}
+
+func TestResourceRepositoryRawHostedStrictContentTypeValidation(t *testing.T) {
+	var created json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/raw/hosted":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/raw/hosted/raw-internal":
+			w.Write(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_hosted"]
+	config := func(storage map[string]interface{}) map[string]interface{} {
+		storage["blob_store_name"] = "default"
+		return map[string]interface{}{
+			"name":    "raw-internal",
+			"online":  true,
+			"storage": []interface{}{storage},
+		}
+	}
+
+	// Nexus validates content types unless told otherwise
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config(map[string]interface{}{}))
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, string(created), `"strictContentTypeValidation":true`)
+
+	disabled := config(map[string]interface{}{"strict_content_type_validation": false})
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, disabled)
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, string(created), `"strictContentTypeValidation":false`)
+	assert.Equal(t, false, resourceData.Get("storage.0.strict_content_type_validation"))
+
+	diff, err := res.Diff(context.Background(), resourceData.State(), terraform.NewResourceConfigRaw(disabled), nexusClient)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "storage.0.strict_content_type_validation")
+	}
+}
+
+func TestResourceRepositoryRawHostedBlobStoreRetry(t *testing.T) {
+	// The blob store was just created and is not yet known to the node which
+	// receives the first create requests
+	var attempts int
+	var created json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/raw/hosted":
+			attempts++
+			if attempts <= 2 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `[{"id":"PARAMETER storage.blobStoreName","message":"Blob store fresh not found"}]`)
+				return
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/raw/hosted/raw-internal":
+			w.Write(created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_hosted"]
+	config := map[string]interface{}{
+		"name":    "raw-internal",
+		"online":  true,
+		"storage": []interface{}{map[string]interface{}{"blob_store_name": "fresh"}},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, "raw-internal", resourceData.Id())
+	assert.Equal(t, "fresh", resourceData.Get("storage.0.blob_store_name"))
+
+	// Without retries the first error is returned
+	attempts = 0
+	api.SetRetries(nexusClient, 0)
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, config)
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "Blob store fresh not found")
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,409 @@
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"text/template"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	nexusSchema "github.com/datadrivers/go-nexus-client/nexus3/schema"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccResourceRepositoryRawProxy() repository.RawProxyRepository {
+	enableCircularRedirects := true
+	enableCookies := true
+	retries := 3
+	timeout := 15
+	useTrustStore := true
+	contentDisposition := repository.RawContentDispositionAttachment
+
+	return repository.RawProxyRepository{
+		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
+		Online: true,
+		Raw: &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		},
+		Storage: repository.Storage{
+			BlobStoreName:               "default",
+			StrictContentTypeValidation: true,
+		},
+		Cleanup: &repository.Cleanup{
+			PolicyNames: []string{"cleanup-weekly"},
+		},
+		HTTPClient: repository.HTTPClient{
+			AutoBlock: true,
+			Blocked:   false,
+			Authentication: &repository.HTTPClientAuthentication{
+				Password: "acceptance-password",
+				Type:     repository.HTTPClientAuthenticationTypeUsername,
+				Username: "acceptance-user",
+			},
+			Connection: &repository.HTTPClientConnection{
+				EnableCircularRedirects: &enableCircularRedirects,
+				EnableCookies:           &enableCookies,
+				Retries:                 &retries,
+				Timeout:                 &timeout,
+				UserAgentSuffix:         "acceptance-test",
+				UseTrustStore:           &useTrustStore,
+			},
+		},
+		NegativeCache: repository.NegativeCache{
+			Enabled: true,
+			TTL:     5,
+		},
+		Proxy: repository.Proxy{
+			ContentMaxAge:  770,
+			MetadataMaxAge: 770,
+			RemoteURL:      "https://nodejs.org/dist/",
+		},
+	}
+}
+
+func testAccResourceRepositoryRawProxyConfig(repo repository.RawProxyRepository) string {
+	buf := &bytes.Buffer{}
+	resourceRepositoryRawProxyTemplate := template.Must(template.New("RawProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryRawProxy))
+	if err := resourceRepositoryRawProxyTemplate.Execute(buf, repo); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceRepositoryRawProxy(t *testing.T) {
+	routingRule := nexusSchema.RoutingRule{
+		Name:        acctest.RandString(10),
+		Description: "acceptance test",
+		Mode:        nexusSchema.RoutingRuleModeAllow,
+		Matchers: []string{
+			"/",
+		},
+	}
+	repo := testAccResourceRepositoryRawProxy()
+	repo.RoutingRule = &routingRule.Name
+	resourceName := "nexus_repository_raw_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRoutingRuleConfig(routingRule) + testAccResourceRepositoryRawProxyConfig(repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
+						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "http_client.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.auto_block", strconv.FormatBool(repo.HTTPClient.AutoBlock)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.blocked", strconv.FormatBool(repo.HTTPClient.Blocked)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.0.type", string(repo.HTTPClient.Authentication.Type)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.0.username", repo.HTTPClient.Authentication.Username),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.0.password", repo.HTTPClient.Authentication.Password),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.connection.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.connection.0.enable_circular_redirects", strconv.FormatBool(*repo.HTTPClient.Connection.EnableCircularRedirects)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.connection.0.enable_cookies", strconv.FormatBool(*repo.HTTPClient.Connection.EnableCookies)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.connection.0.retries", strconv.Itoa(*repo.HTTPClient.Connection.Retries)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.connection.0.timeout", strconv.Itoa(*repo.HTTPClient.Connection.Timeout)),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.connection.0.user_agent_suffix", repo.HTTPClient.Connection.UserAgentSuffix),
+						resource.TestCheckResourceAttr(resourceName, "http_client.0.connection.0.use_trust_store", strconv.FormatBool(*repo.HTTPClient.Connection.UseTrustStore)),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.0.enabled", strconv.FormatBool(repo.NegativeCache.Enabled)),
+						resource.TestCheckResourceAttr(resourceName, "negative_cache.0.ttl", strconv.Itoa(repo.NegativeCache.TTL)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.content_max_age", strconv.Itoa(repo.Proxy.ContentMaxAge)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.metadata_max_age", strconv.Itoa(repo.Proxy.MetadataMaxAge)),
+						resource.TestCheckResourceAttr(resourceName, "proxy.0.remote_url", repo.Proxy.RemoteURL),
+						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.0", repo.Cleanup.PolicyNames[0]),
+						resource.TestCheckResourceAttr(resourceName, "routing_rule", *repo.RoutingRule),
+					),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "raw.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "raw.0.content_disposition", string(*repo.Raw.ContentDisposition)),
+					),
+					testAccCheckRepositoryFormatAndType(repo.Name, "raw", "proxy"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           repo.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.password"},
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryRawProxyCachePriming(t *testing.T) {
+	var fetched []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/raw/proxy":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/raw/proxy/nodejs":
+			fmt.Fprint(w, `{"name":"nodejs","online":true,"storage":{"blobStoreName":"default"},"proxy":{"remoteUrl":"https://nodejs.org/dist/"},"negativeCache":{},"httpClient":{}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/repository/nodejs/index.json":
+			fetched = append(fetched, r.URL.Path)
+			fmt.Fprint(w, `[]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/repository/nodejs/missing.txt":
+			fetched = append(fetched, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_proxy"]
+	config := func(strict bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":           "nodejs",
+			"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+			"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://nodejs.org/dist/"}},
+			"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+			"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+			"prime_paths":    []interface{}{"index.json", "/missing.txt"},
+			"prime_strict":   strict,
+		}
+	}
+
+	diags := res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, config(false)), nexusClient)
+	assert.Equal(t, []string{"/repository/nodejs/index.json", "/repository/nodejs/missing.txt"}, fetched)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, `priming cache of repository "nodejs" with "/missing.txt" failed`, diags[0].Summary)
+
+	diags = res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, config(true)), nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Regexp(t, `HTTP: 404`, diags[0].Detail)
+}
+
+func TestResourceRepositoryRawProxyRemoteURLTrailingSlash(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_proxy"]
+	// Nexus returned the remote URL with a trailing slash
+	state := &terraform.InstanceState{
+		ID: "nodejs",
+		Attributes: map[string]string{
+			"id":                        "nodejs",
+			"name":                      "nodejs",
+			"online":                    "true",
+			"proxy.#":                   "1",
+			"proxy.0.content_max_age":   "1440",
+			"proxy.0.metadata_max_age":  "1440",
+			"proxy.0.remote_url":        "https://nodejs.org/dist/",
+			"storage.#":                 "1",
+			"storage.0.blob_store_name": "default",
+			"storage.0.strict_content_type_validation": "true",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "nodejs",
+		"online":  true,
+		"proxy":   []interface{}{map[string]interface{}{"remote_url": "https://nodejs.org/dist"}},
+		"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+	})
+
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "proxy.0.remote_url")
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":    "nodejs",
+		"online":  true,
+		"proxy":   []interface{}{map[string]interface{}{"remote_url": "https://nodejs.org/download"}},
+		"storage": []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+	})
+	diff, err = res.Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, diff.Attributes, "proxy.0.remote_url")
+}
+
+func TestResourceRepositoryRawProxyConnectionTimeoutDefault(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_proxy"]
+	// Nexus reported its effective timeout for a proxy created without one
+	state := &terraform.InstanceState{
+		ID: "nodejs",
+		Attributes: map[string]string{
+			"id":                         "nodejs",
+			"name":                       "nodejs",
+			"online":                     "true",
+			"http_client.#":              "1",
+			"http_client.0.auto_block":   "true",
+			"http_client.0.blocked":      "false",
+			"http_client.0.connection.#": "1",
+			"http_client.0.connection.0.enable_circular_redirects": "false",
+			"http_client.0.connection.0.enable_cookies":            "false",
+			"http_client.0.connection.0.retries":                   "0",
+			"http_client.0.connection.0.timeout":                   "60",
+			"http_client.0.connection.0.use_trust_store":           "false",
+			"http_client.0.connection.0.user_agent_suffix":         "",
+			"proxy.#":                                  "1",
+			"proxy.0.content_max_age":                  "1440",
+			"proxy.0.metadata_max_age":                 "1440",
+			"proxy.0.remote_url":                       "https://nodejs.org/dist/",
+			"storage.#":                                "1",
+			"storage.0.blob_store_name":                "default",
+			"storage.0.strict_content_type_validation": "true",
+		},
+	}
+	config := func(connection map[string]interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":        "nodejs",
+			"online":      true,
+			"http_client": []interface{}{map[string]interface{}{"auto_block": true, "connection": []interface{}{connection}}},
+			"proxy":       []interface{}{map[string]interface{}{"remote_url": "https://nodejs.org/dist/"}},
+			"storage":     []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+		})
+	}
+
+	diff, err := res.Diff(context.Background(), state, config(map[string]interface{}{"retries": 0}), nil)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "http_client.0.connection.0.timeout")
+	}
+
+	diff, err = res.Diff(context.Background(), state, config(map[string]interface{}{"timeout": 30}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "30", diff.Attributes["http_client.0.connection.0.timeout"].New)
+}
+
+func TestResourceRepositoryRawProxyVerifyRemoteOnCreate(t *testing.T) {
+	verified := false
+	trusted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/raw/proxy":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/raw/proxy/nodejs":
+			fmt.Fprint(w, `{"name":"nodejs","online":true,"storage":{"blobStoreName":"default"},"proxy":{"remoteUrl":"https://nodejs.internal:8443/dist/"},"negativeCache":{},"httpClient":{}}`)
+		case r.Method == http.MethodHead && r.URL.Path == "/repository/nodejs/":
+			// Nexus cannot reach the remote
+			verified = true
+			w.WriteHeader(http.StatusBadGateway)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/ssl":
+			assert.Equal(t, "nodejs.internal", r.URL.Query().Get("host"))
+			assert.Equal(t, "8443", r.URL.Query().Get("port"))
+			if !trusted {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `java.net.UnknownHostException: nodejs.internal`)
+				return
+			}
+			fmt.Fprint(w, `{"subjectCommonName":"nodejs.internal","issuerCommonName":"Example Internal CA","fingerprint":"AB:CD"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_proxy"]
+	config := func(verify bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                    "nodejs",
+			"storage":                 []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+			"proxy":                   []interface{}{map[string]interface{}{"remote_url": "https://nodejs.internal:8443/dist/"}},
+			"negative_cache":          []interface{}{map[string]interface{}{"enabled": true}},
+			"http_client":             []interface{}{map[string]interface{}{"auto_block": true}},
+			"verify_remote_on_create": verify,
+		}
+	}
+
+	// The remote is not verified by default
+	diags := res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, config(false)), nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.False(t, verified)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config(true))
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, verified)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `remote https://nodejs.internal:8443/dist/ of proxy repository "nodejs" is not reachable`, diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "HTTP: 502 Bad Gateway")
+	assert.Contains(t, diags[0].Detail, "Nexus cannot connect to nodejs.internal:8443")
+	assert.Contains(t, diags[0].Detail, "java.net.UnknownHostException: nodejs.internal")
+	// The repository has been created anyway and is tainted
+	assert.Equal(t, "nodejs", resourceData.Id())
+
+	trusted = true
+	diags = res.CreateContext(context.Background(), schema.TestResourceDataRaw(t, res.Schema, config(true)), nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail, "probably does not trust its certificate for nodejs.internal issued by Example Internal CA")
+}
+
+func TestResourceRepositoryRawProxyWithoutNegativeCache(t *testing.T) {
+	var saved repository.RawProxyRepository
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/raw/proxy":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPut && r.URL.Path == "/service/rest/v1/repositories/raw/proxy/nodejs":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/raw/proxy/nodejs":
+			json.NewEncoder(w).Encode(saved)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_raw_proxy"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":        "nodejs",
+		"online":      true,
+		"http_client": []interface{}{map[string]interface{}{"auto_block": true}},
+		"proxy":       []interface{}{map[string]interface{}{"remote_url": "https://nodejs.org/dist/"}},
+		"storage":     []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+	})
+
+	// Without a negative_cache block the schema defaults are sent
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, repository.NegativeCache{Enabled: false, TTL: 1440}, saved.NegativeCache)
+
+	saved.NegativeCache = repository.NegativeCache{}
+	diags = res.UpdateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, repository.NegativeCache{Enabled: false, TTL: 1440}, saved.NegativeCache)
+}
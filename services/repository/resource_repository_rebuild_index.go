@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryRebuildIndex() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to rebuild the search index of a repository of any format, e.g. after a bulk import.
+
+The index is rebuilt once when the resource is created by running the task "Repair - Rebuild repository search". Change ` + "`triggers`" + ` to rebuild it again. Destroying the resource removes the task from Nexus if it is still there.
+By default the resource waits for the task to finish. Use ` + "`timeouts`" + ` to give large repositories more time.`,
+
+		CreateContext: resourceRepositoryRebuildIndexCreate,
+		Read:          resourceRepositoryTaskRead,
+		Delete:        resourceRepositoryTaskRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "Name of the repository to rebuild the search index of",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"triggers": {
+				Description: "Arbitrary values which rebuild the index again when they change",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+			},
+			"wait_for_completion": {
+				Default:     true,
+				Description: "Whether to wait until the index has been rebuilt. Otherwise the rebuild continues in the background.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"task_id": repositoryTaskIDSchema,
+		},
+	}
+}
+
+func resourceRepositoryRebuildIndexCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*api.Client)
+	repositoryName := resourceData.Get("repository").(string)
+
+	err := runRepositoryTask(ctx, resourceData, api.NewTaskService(client),
+		api.TaskTypeRebuildRepositoryIndex,
+		fmt.Sprintf("Rebuild search index of repository %s", repositoryName),
+		map[string]string{
+			"repositoryName": repositoryName,
+		},
+	)
+	if err != nil {
+		return diag.Errorf("rebuilding search index of repository %q: %v", repositoryName, err)
+	}
+	return nil
+}
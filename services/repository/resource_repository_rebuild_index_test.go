@@ -0,0 +1,81 @@
+package repository_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceRepositoryRebuildIndex(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_rebuild_index"]
+
+	server := fakeTaskServer(t, "repository.rebuild-index", map[string]string{"repositoryName": "maven-releases"}, 1, "OK")
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "maven-releases"})
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "task-1", resourceData.Id())
+	assert.False(t, server.left())
+
+	// Without waiting the task is kept until the resource is destroyed
+	server = fakeTaskServer(t, "repository.rebuild-index", map[string]string{"repositoryName": "maven-releases"}, 1, "OK")
+	defer server.Close()
+	nexusClient = api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "maven-releases", "wait_for_completion": false})
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "task-1", resourceData.Id())
+	assert.True(t, server.left())
+}
+
+func TestResourceRepositoryRebuildIndexLeavesNoTask(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_rebuild_index"]
+	properties := map[string]string{"repositoryName": "maven-releases"}
+
+	// A task which could not be started is removed right away
+	server := fakeTaskServer(t, "repository.rebuild-index", properties, 1, "OK")
+	server.runStatus = http.StatusInternalServerError
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "maven-releases"})
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "", resourceData.Id())
+	assert.False(t, server.left())
+
+	// A failed task is removed on destroy
+	server = fakeTaskServer(t, "repository.rebuild-index", properties, 1, "FAILED")
+	defer server.Close()
+	nexusClient = api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "maven-releases"})
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.True(t, diags.HasError())
+	assert.True(t, server.left())
+	assert.NoError(t, res.Delete(resourceData, nexusClient))
+	assert.False(t, server.left())
+
+	// A task which is not waited for is removed on destroy
+	server = fakeTaskServer(t, "repository.rebuild-index", properties, 1, "OK")
+	defer server.Close()
+	nexusClient = api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "maven-releases", "wait_for_completion": false})
+	diags = res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, server.left())
+	assert.NoError(t, res.Delete(resourceData, nexusClient))
+	assert.False(t, server.left())
+
+	// Destroying after the task has been removed in Nexus succeeds
+	assert.NoError(t, res.Delete(resourceData, nexusClient))
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceRepositoryReplication() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to replicate the content of a repository to a repository of another Nexus instance.
+
+Nexus never returns the password of the target instance, so changes of it in Nexus are not detected and it is empty after an import.`,
+
+		Create: resourceRepositoryReplicationCreate,
+		Read:   resourceRepositoryReplicationRead,
+		Update: resourceRepositoryReplicationUpdate,
+		Delete: resourceRepositoryReplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"name": {
+				Description: "Name of the replication connection",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"source_repository": {
+				Description: "Name of the repository whose content is replicated",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"target_url": {
+				Description:  "URL of the Nexus instance the content is replicated to, e.g. `https://nexus-replica.example.com`",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"target_repository": {
+				Computed:    true,
+				Description: "Name of the repository on the target instance. Defaults to `source_repository`",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"username": {
+				Description: "Username used to connect to the target instance",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"password": {
+				Description: "Password used to connect to the target instance",
+				Required:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"include_existing_content": {
+				Default:     false,
+				Description: "Whether content which exists before the connection is created is replicated as well. Default:`false`",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+}
+
+func getRepositoryReplicationFromResourceData(resourceData *schema.ResourceData) api.ReplicationConnection {
+	connection := api.ReplicationConnection{
+		Name:                        resourceData.Get("name").(string),
+		SourceRepositoryName:        resourceData.Get("source_repository").(string),
+		DestinationInstanceURL:      resourceData.Get("target_url").(string),
+		DestinationInstanceUsername: resourceData.Get("username").(string),
+		DestinationInstancePassword: resourceData.Get("password").(string),
+		DestinationRepositoryName:   resourceData.Get("target_repository").(string),
+		ContentRegexes:              []string{},
+		IncludeExistingContent:      resourceData.Get("include_existing_content").(bool),
+	}
+	if connection.DestinationRepositoryName == "" {
+		connection.DestinationRepositoryName = connection.SourceRepositoryName
+	}
+	return connection
+}
+
+func resourceRepositoryReplicationCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	connection := getRepositoryReplicationFromResourceData(resourceData)
+
+	id, err := api.NewReplicationService(client).Create(connection)
+	if err != nil {
+		return fmt.Errorf("creating replication %q: %w", connection.Name, err)
+	}
+
+	resourceData.SetId(id)
+	return resourceRepositoryReplicationRead(resourceData, m)
+}
+
+func resourceRepositoryReplicationRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	connection, err := api.NewReplicationService(client).Get(resourceData.Id())
+	if err != nil {
+		return fmt.Errorf("reading replication %q: %w", resourceData.Id(), err)
+	}
+
+	if connection == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	resourceData.Set("name", connection.Name)
+	resourceData.Set("source_repository", connection.SourceRepositoryName)
+	resourceData.Set("target_url", connection.DestinationInstanceURL)
+	resourceData.Set("target_repository", connection.DestinationRepositoryName)
+	resourceData.Set("username", connection.DestinationInstanceUsername)
+	resourceData.Set("include_existing_content", connection.IncludeExistingContent)
+
+	return nil
+}
+
+func resourceRepositoryReplicationUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	connection := getRepositoryReplicationFromResourceData(resourceData)
+	connection.ID = resourceData.Id()
+	if err := api.NewReplicationService(client).Update(resourceData.Id(), connection); err != nil {
+		return fmt.Errorf("updating replication %q: %w", resourceData.Id(), err)
+	}
+
+	return resourceRepositoryReplicationRead(resourceData, m)
+}
+
+func resourceRepositoryReplicationDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	if err := api.NewReplicationService(client).Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting replication %q: %w", resourceData.Id(), err)
+	}
+
+	resourceData.SetId("")
+	return nil
+}
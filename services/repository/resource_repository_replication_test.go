@@ -0,0 +1,87 @@
+package repository_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccResourceRepositoryReplicationConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_replication" "acceptance" {
+	name                     = "%s"
+	source_repository        = nexus_repository_raw_hosted.acceptance.name
+	target_url               = "%s"
+	username                 = "%s"
+	password                 = "%s"
+	include_existing_content = true
+}
+`, name, os.Getenv("NEXUS_URL"), os.Getenv("NEXUS_USERNAME"), os.Getenv("NEXUS_PASSWORD"))
+}
+
+func TestAccResourceRepositoryReplication(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	repo := testAccResourceRepositoryRawHosted()
+	name := fmt.Sprintf("acceptance-replication-%s", acctest.RandString(10))
+	resourceName := "nexus_repository_replication.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryRawHostedConfig(repo) + testAccResourceRepositoryReplicationConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "source_repository", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "target_repository", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "target_url", os.Getenv("NEXUS_URL")),
+					resource.TestCheckResourceAttr(resourceName, "include_existing_content", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryReplicationRequiresPro(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "Nexus/3.38.1-01 (OSS)")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	nexusClient := api.NewClient(client.Config{URL: server.URL, Username: "admin", Password: "admin123"}, nil)
+
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_replication"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":              "raw-replica",
+		"source_repository": "raw-releases",
+		"target_url":        "https://nexus-replica.example.com",
+		"username":          "replicator",
+		"password":          "s3cr3t",
+	})
+
+	err := res.Create(resourceData, nexusClient)
+	assert.EqualError(t, err, `creating replication "raw-replica": could not create replication connection 'raw-replica', repository replication requires Nexus PRO: HTTP: 404`)
+	assert.Empty(t, resourceData.Id())
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The read-modify-write of a proxy's routing rule is serialized per proxy
+var repositoryProxyLocks sync.Map
+
+func lockRepositoryProxy(name string) func() {
+	lock, _ := repositoryProxyLocks.LoadOrStore(name, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+func ResourceRepositoryRoutingRuleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to assign a routing rule to an existing proxy repository of any format.
+
+This allows to manage the routing rule of a proxy independently of the proxy repository. The proxy repository itself must not manage its routing rule in that case, e.g. ignore changes to it via ` + "`lifecycle { ignore_changes = [routing_rule] }`" + `.`,
+
+		Create: resourceRepositoryRoutingRuleAssignmentCreate,
+		Read:   resourceRepositoryRoutingRuleAssignmentRead,
+		Delete: resourceRepositoryRoutingRuleAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "Name of the proxy repository",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"routing_rule": {
+				Description: "Name of the routing rule to assign to the proxy repository",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func parseRepositoryRoutingRuleAssignmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid routing rule assignment ID %q, expected <repository>/<routing_rule>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// getRepositoryProxy reads the current state of the proxy repository with the
+// given name, looking up its format in the repository list
+func getRepositoryProxy(client *api.Client, name string) (*api.RepositoryProxy, error) {
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range repositories {
+		if repo.Name != name {
+			continue
+		}
+		if repo.Type != repository.RepositoryTypeProxy {
+			return nil, fmt.Errorf("repository is a %s repository, expected a proxy repository", repo.Type)
+		}
+		return api.NewRepositoryProxyService(client).Get(repo.Format, name)
+	}
+	return nil, nil
+}
+
+func resourceRepositoryRoutingRuleAssignmentCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	repositoryName := resourceData.Get("repository").(string)
+	routingRule := resourceData.Get("routing_rule").(string)
+
+	unlock := lockRepositoryProxy(repositoryName)
+	defer unlock()
+
+	proxy, err := getRepositoryProxy(client, repositoryName)
+	if err != nil {
+		return fmt.Errorf("assigning routing rule %q to proxy repository %q: %w", routingRule, repositoryName, err)
+	}
+	if proxy == nil {
+		return fmt.Errorf("assigning routing rule %q to proxy repository %q: proxy repository not found", routingRule, repositoryName)
+	}
+
+	if proxy.RoutingRule != routingRule {
+		proxy.RoutingRule = routingRule
+		if err := api.NewRepositoryProxyService(client).Update(proxy); err != nil {
+			return fmt.Errorf("assigning routing rule %q to proxy repository %q: %w", routingRule, repositoryName, err)
+		}
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", repositoryName, routingRule))
+	return resourceRepositoryRoutingRuleAssignmentRead(resourceData, m)
+}
+
+func resourceRepositoryRoutingRuleAssignmentRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repositoryName, routingRule, err := parseRepositoryRoutingRuleAssignmentID(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	proxy, err := getRepositoryProxy(client, repositoryName)
+	if err != nil {
+		return fmt.Errorf("reading routing rule %q of proxy repository %q: %w", routingRule, repositoryName, err)
+	}
+	if proxy == nil || proxy.RoutingRule != routingRule {
+		resourceData.SetId("")
+		return nil
+	}
+
+	resourceData.Set("repository", repositoryName)
+	resourceData.Set("routing_rule", routingRule)
+
+	return nil
+}
+
+func resourceRepositoryRoutingRuleAssignmentDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	repositoryName, routingRule, err := parseRepositoryRoutingRuleAssignmentID(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	unlock := lockRepositoryProxy(repositoryName)
+	defer unlock()
+
+	// Leave a routing rule alone which was assigned in the meantime
+	proxy, err := getRepositoryProxy(client, repositoryName)
+	if err != nil {
+		return fmt.Errorf("removing routing rule %q from proxy repository %q: %w", routingRule, repositoryName, err)
+	}
+	if proxy == nil || proxy.RoutingRule != routingRule {
+		return nil
+	}
+
+	proxy.RoutingRule = ""
+	if err := api.NewRepositoryProxyService(client).Update(proxy); err != nil {
+		return fmt.Errorf("removing routing rule %q from proxy repository %q: %w", routingRule, repositoryName, err)
+	}
+	return nil
+}
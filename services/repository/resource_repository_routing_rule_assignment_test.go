@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceRepositoryRoutingRuleAssignment(t *testing.T) {
+	var routingRule interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories":
+			fmt.Fprint(w, `[{"name":"npmjs","format":"npm","type":"proxy"},{"name":"npm-private","format":"npm","type":"hosted"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/npm/proxy/npmjs":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"name":            "npmjs",
+				"online":          true,
+				"storage":         map[string]interface{}{"blobStoreName": "default", "strictContentTypeValidation": true},
+				"proxy":           map[string]interface{}{"remoteUrl": "https://registry.npmjs.org"},
+				"routingRuleName": routingRule,
+			})
+		case r.Method == http.MethodPut && r.URL.Path == "/service/rest/v1/repositories/npm/proxy/npmjs":
+			var repo map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&repo))
+			assert.Equal(t, "https://registry.npmjs.org", repo["proxy"].(map[string]interface{})["remoteUrl"])
+			routingRule = repo["routingRule"]
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_routing_rule_assignment"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "npmjs", "routing_rule": "block-internal"})
+	assert.NoError(t, res.Create(resourceData, nexusClient))
+	assert.Equal(t, "block-internal", routingRule)
+	assert.Equal(t, "npmjs/block-internal", resourceData.Id())
+
+	assert.NoError(t, res.Delete(resourceData, nexusClient))
+	assert.Nil(t, routingRule)
+
+	// A rule which is no longer assigned to the proxy is removed from state
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, "", resourceData.Id())
+
+	// Only proxy repositories have a routing rule
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"repository": "npm-private", "routing_rule": "block-internal"})
+	assert.EqualError(t, res.Create(resourceData, nexusClient), `assigning routing rule "block-internal" to proxy repository "npm-private": repository is a hosted repository, expected a proxy repository`)
+}
@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,23 +15,30 @@ func ResourceRepositoryYumGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group yum repository.",
 
-		Create: resourceYumGroupRepositoryCreate,
-		Delete: resourceYumGroupRepositoryDelete,
-		Exists: resourceYumGroupRepositoryExists,
-		Read:   resourceYumGroupRepositoryRead,
-		Update: resourceYumGroupRepositoryUpdate,
+		CreateContext: withContext(createWithAdoption(repository.RepositoryFormatYum, repository.RepositoryTypeGroup, createWithBlobStoreRetry(resourceYumGroupRepositoryCreate), resourceYumGroupRepositoryRead)),
+		DeleteContext: withContext(deleteWithProtection(resourceYumGroupRepositoryDelete)),
+		Exists:        resourceYumGroupRepositoryExists,
+		ReadContext:   withContext(resourceYumGroupRepositoryRead),
+		UpdateContext: withContext(resourceYumGroupRepositoryUpdate),
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: validateGroupMemberNames,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatYum, repository.RepositoryTypeGroup),
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
 			// Group schemas
-			"group":   repositorySchema.ResourceGroup,
-			"storage": repositorySchema.ResourceStorage,
+			"group":                  repositorySchema.ResourceGroup,
+			"member_order_sensitive": repositorySchema.ResourceGroupMemberOrderSensitive,
+			"storage":                repositorySchema.ResourceStorage,
 			// Yum group schemas
 			"yum_signing": repositorySchema.ResourceYumSigning,
 		},
@@ -40,7 +49,7 @@ func getYumGroupRepositoryFromResourceData(resourceData *schema.ResourceData) re
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
@@ -88,24 +97,24 @@ func setYumGroupRepositoryToResourceData(repo *repository.YumGroupRepository, re
 }
 
 func resourceYumGroupRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getYumGroupRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Yum.Group.Create(repo); err != nil {
-		return err
+		return fmt.Errorf("creating yum group repository %q: %w", repo.Name, err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceYumGroupRepositoryRead(resourceData, m)
+	return readRepositoryAfterCreate(resourceData, m, resourceYumGroupRepositoryRead)
 }
 
 func resourceYumGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Yum.Group.Get(resourceData.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading yum group repository %q: %w", resourceData.Id(), err)
 	}
 
 	if repo == nil {
@@ -113,29 +122,34 @@ func resourceYumGroupRepositoryRead(resourceData *schema.ResourceData, m interfa
 		return nil
 	}
 
+	setRepositoryFormat(resourceData, repository.RepositoryFormatYum, repository.RepositoryTypeGroup)
 	return setYumGroupRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceYumGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := resourceData.Id()
 	repo := getYumGroupRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Yum.Group.Update(repoName, repo); err != nil {
-		return err
+		return fmt.Errorf("updating yum group repository %q: %w", repoName, err)
 	}
 
 	return resourceYumGroupRepositoryRead(resourceData, m)
 }
 
 func resourceYumGroupRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Yum.Group.Delete(resourceData.Id())
+	client := m.(*api.Client)
+
+	if err := client.Repository.Yum.Group.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting yum group repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
 }
 
 func resourceYumGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Yum.Group.Get(resourceData.Id())
 	return repo != nil, err
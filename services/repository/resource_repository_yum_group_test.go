@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strconv"
 	"testing"
@@ -12,6 +13,9 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceRepositoryYumGroup() repository.YumGroupRepository {
@@ -84,3 +88,61 @@ func TestAccResourceRepositoryYumGroup(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceRepositoryYumGroupSelfMember(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_yum_group"]
+	config := func(memberNames ...interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":    "yum-public",
+			"online":  true,
+			"group":   []interface{}{map[string]interface{}{"member_names": memberNames}},
+			"storage": []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+		})
+	}
+
+	_, err := res.Diff(context.Background(), nil, config("yum-hosted", "yum-proxy"), nil)
+	assert.NoError(t, err)
+
+	_, err = res.Diff(context.Background(), nil, config("yum-hosted", "yum-public"), nil)
+	assert.EqualError(t, err, `group.0.member_names must not contain the group repository "yum-public" itself`)
+}
+
+func TestResourceRepositoryYumGroupMemberOrder(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_yum_group"]
+	config := func(orderSensitive bool, memberNames ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                   "yum-public",
+			"online":                 true,
+			"member_order_sensitive": orderSensitive,
+			"group":                  []interface{}{map[string]interface{}{"member_names": memberNames}},
+			"storage":                []interface{}{map[string]interface{}{"blob_store_name": "default", "strict_content_type_validation": true}},
+		}
+	}
+	state := func(orderSensitive bool) *terraform.InstanceState {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, config(orderSensitive, "yum-hosted", "yum-proxy"))
+		resourceData.SetId("yum-public")
+		return resourceData.State()
+	}
+
+	// By default the order is managed, as it decides which member Nexus searches first
+	diff, err := res.Diff(context.Background(), state(true), terraform.NewResourceConfigRaw(config(true, "yum-proxy", "yum-hosted")), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Equal(t, "yum-proxy", diff.Attributes["group.0.member_names.0"].New)
+		assert.Equal(t, "yum-hosted", diff.Attributes["group.0.member_names.1"].New)
+	}
+
+	// Only the membership is managed when the order is ignored
+	diff, err = res.Diff(context.Background(), state(false), terraform.NewResourceConfigRaw(config(false, "yum-proxy", "yum-hosted")), nil)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "group.0.member_names.0")
+		assert.NotContains(t, diff.Attributes, "group.0.member_names.1")
+	}
+
+	diff, err = res.Diff(context.Background(), state(false), terraform.NewResourceConfigRaw(config(false, "yum-proxy", "yum-releases")), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Equal(t, "yum-releases", diff.Attributes["group.0.member_names.1"].New)
+	}
+}
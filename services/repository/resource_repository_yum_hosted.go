@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,20 +15,26 @@ func ResourceRepositoryYumHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted yum repository.",
 
-		Create: resourceYumHostedRepositoryCreate,
-		Delete: resourceYumHostedRepositoryDelete,
-		Exists: resourceYumHostedRepositoryExists,
-		Read:   resourceYumHostedRepositoryRead,
-		Update: resourceYumHostedRepositoryUpdate,
+		CreateContext: withContext(createWithAdoption(repository.RepositoryFormatYum, repository.RepositoryTypeHosted, createWithBlobStoreRetry(resourceYumHostedRepositoryCreate), resourceYumHostedRepositoryRead)),
+		DeleteContext: withContext(deleteWithProtection(deleteWithComponentPurge(resourceYumHostedRepositoryDelete))),
+		Exists:        resourceYumHostedRepositoryExists,
+		ReadContext:   withContext(resourceYumHostedRepositoryRead),
+		UpdateContext: withContext(resourceYumHostedRepositoryUpdate),
+		Timeouts:      repositoryTimeouts(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatYum, repository.RepositoryTypeHosted),
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
+			"purge_on_destroy":    repositorySchema.ResourcePurgeOnDestroy,
 			// Hosted schemas
 			"cleanup":   repositorySchema.ResourceCleanup,
 			"component": repositorySchema.ResourceComponent,
@@ -105,7 +113,7 @@ func setYumHostedRepositoryToResourceData(repo *repository.YumHostedRepository,
 	}
 
 	if repo.Cleanup != nil {
-		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
 			return err
 		}
 	}
@@ -120,24 +128,24 @@ func setYumHostedRepositoryToResourceData(repo *repository.YumHostedRepository,
 }
 
 func resourceYumHostedRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getYumHostedRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Yum.Hosted.Create(repo); err != nil {
-		return err
+		return fmt.Errorf("creating yum hosted repository %q: %w", repo.Name, err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceYumHostedRepositoryRead(resourceData, m)
+	return readRepositoryAfterCreate(resourceData, m, resourceYumHostedRepositoryRead)
 }
 
 func resourceYumHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Yum.Hosted.Get(resourceData.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading yum hosted repository %q: %w", resourceData.Id(), err)
 	}
 
 	if repo == nil {
@@ -145,29 +153,34 @@ func resourceYumHostedRepositoryRead(resourceData *schema.ResourceData, m interf
 		return nil
 	}
 
+	setRepositoryFormat(resourceData, repository.RepositoryFormatYum, repository.RepositoryTypeHosted)
 	return setYumHostedRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceYumHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := resourceData.Id()
 	repo := getYumHostedRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Yum.Hosted.Update(repoName, repo); err != nil {
-		return err
+		return fmt.Errorf("updating yum hosted repository %q: %w", repoName, err)
 	}
 
 	return resourceYumHostedRepositoryRead(resourceData, m)
 }
 
 func resourceYumHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Yum.Hosted.Delete(resourceData.Id())
+	client := m.(*api.Client)
+
+	if err := client.Repository.Yum.Hosted.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting yum hosted repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
 }
 
 func resourceYumHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Yum.Hosted.Get(resourceData.Id())
 	return repo != nil, err
@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	repositorySchema "github.com/SimCubeLtd/terraform-provider-nexus/schema/repository"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,27 +15,36 @@ func ResourceRepositoryYumProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a yum proxy repository.",
 
-		Create: resourceYumProxyRepositoryCreate,
-		Delete: resourceYumProxyRepositoryDelete,
-		Exists: resourceYumProxyRepositoryExists,
-		Read:   resourceYumProxyRepositoryRead,
-		Update: resourceYumProxyRepositoryUpdate,
+		CreateContext: createWithCachePriming(createWithAdoption(repository.RepositoryFormatYum, repository.RepositoryTypeProxy, createWithBlobStoreRetry(resourceYumProxyRepositoryCreate), resourceYumProxyRepositoryRead)),
+		DeleteContext: withContext(deleteWithProtection(resourceYumProxyRepositoryDelete)),
+		Exists:        resourceYumProxyRepositoryExists,
+		ReadContext:   withContext(resourceYumProxyRepositoryRead),
+		UpdateContext: withContext(resourceYumProxyRepositoryUpdate),
+		Timeouts:      repositoryTimeouts(),
+		CustomizeDiff: validateHTTPClientAuthentication,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryOfFormat(repository.RepositoryFormatYum, repository.RepositoryTypeProxy),
 		},
 
 		Schema: map[string]*schema.Schema{
 			// Common schemas
-			"id":     common.ResourceID,
-			"name":   repositorySchema.ResourceName,
-			"online": repositorySchema.ResourceOnline,
+			"id":                  common.ResourceID,
+			"name":                repositorySchema.ResourceName,
+			"online":              repositorySchema.ResourceOnline,
+			"deletion_protection": repositorySchema.ResourceDeletionProtection,
+			"adopt_existing":      repositorySchema.ResourceAdoptExisting,
+			"format":              repositorySchema.ResourceFormat,
+			"type":                repositorySchema.ResourceType,
 			// Proxy schemas
-			"cleanup":        repositorySchema.ResourceCleanup,
-			"http_client":    repositorySchema.ResourceHTTPClient,
-			"negative_cache": repositorySchema.ResourceNegativeCache,
-			"proxy":          repositorySchema.ResourceProxy,
-			"routing_rule":   repositorySchema.ResourceRoutingRule,
-			"storage":        repositorySchema.ResourceStorage,
+			"cleanup":                 repositorySchema.ResourceCleanup,
+			"http_client":             repositorySchema.ResourceHTTPClient,
+			"negative_cache":          repositorySchema.ResourceNegativeCache,
+			"prime_paths":             repositorySchema.ResourcePrimePaths,
+			"prime_strict":            repositorySchema.ResourcePrimeStrict,
+			"verify_remote_on_create": repositorySchema.ResourceVerifyRemoteOnCreate,
+			"proxy":                   repositorySchema.ResourceProxy,
+			"routing_rule":            repositorySchema.ResourceRoutingRule,
+			"storage":                 repositorySchema.ResourceStorage,
 			// Yum proxy schemas
 			"yum_signing": repositorySchema.ResourceYumSigning,
 		},
@@ -42,7 +53,6 @@ func ResourceRepositoryYumProxy() *schema.Resource {
 
 func getYumProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.YumProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
@@ -57,10 +67,7 @@ func getYumProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCacheFromResourceData(resourceData),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -150,7 +157,7 @@ func setYumProxyRepositoryToResourceData(repo *repository.YumProxyRepository, re
 		return err
 	}
 
-	if err := resourceData.Set("negative_cache", flattenNegativeCache(&repo.NegativeCache)); err != nil {
+	if err := resourceData.Set("negative_cache", flattenNegativeCache(normalizeNegativeCache(&repo.NegativeCache, resourceData))); err != nil {
 		return err
 	}
 
@@ -159,7 +166,13 @@ func setYumProxyRepositoryToResourceData(repo *repository.YumProxyRepository, re
 	}
 
 	if repo.Cleanup != nil {
-		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(normalizeCleanup(repo.Cleanup, resourceData))); err != nil {
+			return err
+		}
+	}
+
+	if repo.YumSigning != nil {
+		if err := resourceData.Set("yum_signing", flattenYumSigning(repo.YumSigning, resourceData)); err != nil {
 			return err
 		}
 	}
@@ -167,24 +180,24 @@ func setYumProxyRepositoryToResourceData(repo *repository.YumProxyRepository, re
 }
 
 func resourceYumProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo := getYumProxyRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Yum.Proxy.Create(repo); err != nil {
-		return err
+		return fmt.Errorf("creating yum proxy repository %q: %w", repo.Name, err)
 	}
 	resourceData.SetId(repo.Name)
 
-	return resourceYumProxyRepositoryRead(resourceData, m)
+	return readRepositoryAfterCreate(resourceData, m, resourceYumProxyRepositoryRead)
 }
 
 func resourceYumProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Yum.Proxy.Get(resourceData.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading yum proxy repository %q: %w", resourceData.Id(), err)
 	}
 
 	if repo == nil {
@@ -192,29 +205,34 @@ func resourceYumProxyRepositoryRead(resourceData *schema.ResourceData, m interfa
 		return nil
 	}
 
+	setRepositoryFormat(resourceData, repository.RepositoryFormatYum, repository.RepositoryTypeProxy)
 	return setYumProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceYumProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repoName := resourceData.Id()
 	repo := getYumProxyRepositoryFromResourceData(resourceData)
 
 	if err := client.Repository.Yum.Proxy.Update(repoName, repo); err != nil {
-		return err
+		return fmt.Errorf("updating yum proxy repository %q: %w", repoName, err)
 	}
 
 	return resourceYumProxyRepositoryRead(resourceData, m)
 }
 
 func resourceYumProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-	return client.Repository.Yum.Proxy.Delete(resourceData.Id())
+	client := m.(*api.Client)
+
+	if err := client.Repository.Yum.Proxy.Delete(resourceData.Id()); err != nil {
+		return fmt.Errorf("deleting yum proxy repository %q: %w", resourceData.Id(), err)
+	}
+	return nil
 }
 
 func resourceYumProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	repo, err := client.Repository.Yum.Proxy.Get(resourceData.Id())
 	return repo != nil, err
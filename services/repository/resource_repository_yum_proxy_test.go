@@ -2,17 +2,25 @@ package repository_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"text/template"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	"github.com/datadrivers/go-nexus-client/nexus3/schema"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	nexusSchema "github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceRepositoryYumProxy() repository.YumProxyRepository {
@@ -75,10 +83,10 @@ func testAccResourceRepositoryYumProxyConfig(repo repository.YumProxyRepository)
 }
 
 func TestAccResourceRepositoryYumProxy(t *testing.T) {
-	routingRule := schema.RoutingRule{
+	routingRule := nexusSchema.RoutingRule{
 		Name:        acctest.RandString(10),
 		Description: "acceptance test",
-		Mode:        schema.RoutingRuleModeAllow,
+		Mode:        nexusSchema.RoutingRuleModeAllow,
 		Matchers: []string{
 			"/",
 		},
@@ -98,6 +106,8 @@ func TestAccResourceRepositoryYumProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+						resource.TestCheckResourceAttr(resourceName, "format", repository.RepositoryFormatYum),
+						resource.TestCheckResourceAttr(resourceName, "type", repository.RepositoryTypeProxy),
 					),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "http_client.#", "1"),
@@ -146,3 +156,112 @@ func TestAccResourceRepositoryYumProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceRepositoryYumProxyYumSigning(t *testing.T) {
+	var created repository.YumProxyRepository
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/repositories/yum/proxy":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/yum/proxy/centos":
+			// Nexus does not return the passphrase
+			fmt.Fprint(w, `{"name":"centos","online":true,"storage":{"blobStoreName":"default"},"proxy":{"remoteUrl":"https://mirror.centos.org/centos/"},"negativeCache":{},"httpClient":{},"yumSigning":{"keypair":"my-keypair"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_yum_proxy"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":           "centos",
+		"storage":        []interface{}{map[string]interface{}{"blob_store_name": "default"}},
+		"proxy":          []interface{}{map[string]interface{}{"remote_url": "https://mirror.centos.org/centos/"}},
+		"negative_cache": []interface{}{map[string]interface{}{"enabled": true}},
+		"http_client":    []interface{}{map[string]interface{}{"auto_block": true}},
+		"yum_signing":    []interface{}{map[string]interface{}{"keypair": "my-keypair", "passphrase": "my-passphrase"}},
+	})
+
+	diags := res.CreateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError())
+	if assert.NotNil(t, created.YumSigning) {
+		assert.Equal(t, "my-keypair", *created.YumSigning.Keypair)
+		assert.Equal(t, "my-passphrase", *created.YumSigning.Passphrase)
+	}
+	assert.Equal(t, 1, resourceData.Get("yum_signing.#"))
+	assert.Equal(t, "my-keypair", resourceData.Get("yum_signing.0.keypair"))
+	assert.Equal(t, "my-passphrase", resourceData.Get("yum_signing.0.passphrase"))
+}
+
+func TestResourceRepositoryYumProxyFormat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/yum/proxy/centos" {
+			fmt.Fprint(w, `{"name":"centos","online":true,"storage":{"blobStoreName":"default"},"proxy":{"remoteUrl":"https://mirror.centos.org/centos/"},"negativeCache":{},"httpClient":{}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_yum_proxy"]
+	resourceData := res.Data(nil)
+	resourceData.SetId("centos")
+
+	diags := res.ReadContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "yum", resourceData.Get("format"))
+	assert.Equal(t, "proxy", resourceData.Get("type"))
+}
+
+func TestResourceRepositoryYumProxyImportedWithoutNegativeCache(t *testing.T) {
+	saved := repository.YumProxyRepository{
+		Name:          "centos",
+		Online:        true,
+		Storage:       repository.Storage{BlobStoreName: "default", StrictContentTypeValidation: true},
+		HTTPClient:    repository.HTTPClient{AutoBlock: true},
+		NegativeCache: repository.NegativeCache{Enabled: false, TTL: 1440},
+		Proxy:         repository.Proxy{RemoteURL: "http://mirror.centos.org/centos/", ContentMaxAge: 1440, MetadataMaxAge: 1440},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/service/rest/v1/repositories/yum/proxy/centos":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/repositories/yum/proxy/centos":
+			json.NewEncoder(w).Encode(saved)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_repository_yum_proxy"]
+
+	// The default negative cache of an imported proxy is left out of state
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	resourceData.SetId("centos")
+	diags := res.ReadContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, resourceData.Get("negative_cache"))
+
+	saved.NegativeCache = repository.NegativeCache{}
+	diags = res.UpdateContext(context.Background(), resourceData, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, repository.NegativeCache{Enabled: false, TTL: 1440}, saved.NegativeCache)
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// repositoryTaskIDSchema is the ID of the task of resources which run a task
+// once. It is empty once the task has been removed from Nexus.
+var repositoryTaskIDSchema = &schema.Schema{
+	Computed:    true,
+	Description: "ID of the task in Nexus. The task is kept until the resource is destroyed unless it has been waited for and succeeded, then it is removed right away and the ID is empty.",
+	Type:        schema.TypeString,
+}
+
+// runRepositoryTask creates a manually scheduled task and runs it once. The
+// ID of the task becomes the ID of the resource and is tracked in task_id
+// until the task is removed. If wait_for_completion is set, it waits for the
+// task to finish and removes it, a failed task is kept for troubleshooting
+// though. A task which could not be started is removed right away.
+func runRepositoryTask(ctx context.Context, resourceData *schema.ResourceData, service *api.TaskService, typeID string, name string, properties map[string]string) error {
+	taskID, err := service.Create(typeID, name, properties)
+	if err != nil {
+		return err
+	}
+	if err := service.Run(taskID); err != nil {
+		if deleteErr := service.Delete(taskID); deleteErr != nil {
+			return fmt.Errorf("%w, and removing the task failed: %v", err, deleteErr)
+		}
+		return err
+	}
+	resourceData.SetId(taskID)
+	if err := resourceData.Set("task_id", taskID); err != nil {
+		return err
+	}
+
+	if !resourceData.Get("wait_for_completion").(bool) {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{api.TaskStateRunning},
+		Target:  []string{"done"},
+		Refresh: repositoryTaskRefresh(service, taskID),
+		Timeout: resourceData.Timeout(schema.TimeoutCreate),
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for task %s: %w", taskID, err)
+	}
+
+	task := result.(*api.Task)
+	if task.LastRunResult == api.TaskResultFailed {
+		return fmt.Errorf("task %s failed: %s", taskID, task.Message)
+	}
+
+	// The task was only needed for this run
+	if err := service.Delete(taskID); err != nil {
+		return err
+	}
+	return resourceData.Set("task_id", "")
+}
+
+// repositoryTaskRefresh reports the task as done once it has a result of its
+// first and only run
+func repositoryTaskRefresh(service *api.TaskService, taskID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := service.Get(taskID)
+		if err != nil {
+			return nil, "", err
+		}
+		if task == nil {
+			return nil, "", fmt.Errorf("task %s not found", taskID)
+		}
+		if task.CurrentState == api.TaskStateRunning || task.LastRunResult == "" {
+			return task, api.TaskStateRunning, nil
+		}
+		return task, "done", nil
+	}
+}
+
+// resourceRepositoryTaskRead is the read of resources which run a task once,
+// there is no remote state to refresh
+func resourceRepositoryTaskRead(resourceData *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+// resourceRepositoryTaskDelete is the delete of resources which don't leave
+// anything behind in Nexus
+func resourceRepositoryTaskDelete(resourceData *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+// resourceRepositoryTaskRunDelete removes the task tracked in task_id, unless
+// it has already been removed, in Nexus or by runRepositoryTask
+func resourceRepositoryTaskRunDelete(resourceData *schema.ResourceData, m interface{}) error {
+	taskID := resourceData.Get("task_id").(string)
+	if taskID == "" {
+		return nil
+	}
+
+	service := api.NewTaskService(m.(*api.Client))
+	task, err := service.Get(taskID)
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		return nil
+	}
+	return service.Delete(taskID)
+}
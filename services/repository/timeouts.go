@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// repositoryTimeouts returns the default timeouts of the repository
+// resources. A Nexus which has just been started may take minutes until it
+// handles repository changes.
+func repositoryTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(10 * time.Minute),
+		Read:   schema.DefaultTimeout(5 * time.Minute),
+		Update: schema.DefaultTimeout(10 * time.Minute),
+		Delete: schema.DefaultTimeout(10 * time.Minute),
+	}
+}
+
+// withContext adapts a CRUD function to a context aware one which honors the
+// timeout of the operation and the max_concurrent_requests of the provider.
+// The operation waits for a free request slot and holds it until the function
+// returns, as it sends its requests one after the other. The function gets a
+// client whose requests of the api package are canceled once the context is
+// done, retries stop waiting at that point as well. go-nexus-client does not
+// accept a context, so the operation stops waiting for the function once the
+// context is done and leaves a pending request of go-nexus-client behind. Its
+// outcome is lost, a repository it creates anyway can be adopted with
+// adopt_existing.
+func withContext(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+		client := m.(*api.Client)
+
+		// The resource data initializes itself on first use, which must not
+		// race with the SDK reading the state once the timeout has expired
+		resourceData.Get("name")
+
+		slotCtx, release, err := api.AcquireRequestSlot(ctx, client)
+		if err == nil {
+			done := make(chan error, 1)
+			go func() {
+				// The slot is in use until the request of go-nexus-client
+				// has finished, even if the operation stopped waiting for it
+				defer release()
+				done <- f(resourceData, api.WithContext(slotCtx, client))
+			}()
+
+			select {
+			case err = <-done:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+		}
+		if err != nil && ctx.Err() != nil {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Nexus did not respond in time",
+				Detail:   "Increase the timeouts of the resource if Nexus needs more time, e.g. right after startup: " + err.Error(),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+}
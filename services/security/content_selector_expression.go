@@ -0,0 +1,217 @@
+package security
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Nexus does not offer an endpoint to evaluate a content selector expression
+// against a single path, so CSEL is evaluated locally. CSEL is a small subset
+// of JEXL: comparisons of the variables format and path against string
+// literals combined with and, or, not and parentheses.
+
+type cselTokenKind int
+
+const (
+	cselIdentifier cselTokenKind = iota
+	cselString
+	cselOperator
+	cselEnd
+)
+
+type cselToken struct {
+	kind  cselTokenKind
+	value string
+	pos   int
+}
+
+var cselOperators = []string{"==", "!=", "=~", "=^", "&&", "||", "!", "(", ")"}
+
+func tokenizeCSEL(expression string) ([]cselToken, error) {
+	var tokens []cselToken
+	for pos := 0; pos < len(expression); {
+		char := expression[pos]
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r':
+			pos++
+		case char == '"' || char == '\'':
+			start := pos
+			var value strings.Builder
+			for pos++; pos < len(expression) && expression[pos] != char; pos++ {
+				if expression[pos] == '\\' && pos+1 < len(expression) {
+					pos++
+				}
+				value.WriteByte(expression[pos])
+			}
+			if pos >= len(expression) {
+				return nil, fmt.Errorf("unterminated string starting at position %d", start)
+			}
+			pos++
+			tokens = append(tokens, cselToken{kind: cselString, value: value.String(), pos: start})
+		case isCSELIdentifierChar(char):
+			start := pos
+			for pos < len(expression) && (isCSELIdentifierChar(expression[pos]) || expression[pos] == '.') {
+				pos++
+			}
+			tokens = append(tokens, cselToken{kind: cselIdentifier, value: expression[start:pos], pos: start})
+		default:
+			operator := ""
+			for _, candidate := range cselOperators {
+				if strings.HasPrefix(expression[pos:], candidate) {
+					operator = candidate
+					break
+				}
+			}
+			if operator == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", char, pos)
+			}
+			tokens = append(tokens, cselToken{kind: cselOperator, value: operator, pos: pos})
+			pos += len(operator)
+		}
+	}
+	return append(tokens, cselToken{kind: cselEnd, pos: len(expression)}), nil
+}
+
+func isCSELIdentifierChar(char byte) bool {
+	return char == '_' || char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char >= '0' && char <= '9'
+}
+
+// cselParser is a recursive descent parser which evaluates the expression
+// while parsing it
+type cselParser struct {
+	tokens    []cselToken
+	next      int
+	variables map[string]string
+}
+
+// evaluateCSEL returns whether the content selector expression matches the
+// given variables
+func evaluateCSEL(expression string, variables map[string]string) (bool, error) {
+	tokens, err := tokenizeCSEL(expression)
+	if err != nil {
+		return false, err
+	}
+	parser := &cselParser{tokens: tokens, variables: variables}
+	matched, err := parser.parseOr()
+	if err != nil {
+		return false, err
+	}
+	if token := parser.peek(); token.kind != cselEnd {
+		return false, fmt.Errorf("unexpected %q at position %d", token.value, token.pos)
+	}
+	return matched, nil
+}
+
+func (p *cselParser) peek() cselToken {
+	return p.tokens[p.next]
+}
+
+func (p *cselParser) consume() cselToken {
+	token := p.tokens[p.next]
+	if token.kind != cselEnd {
+		p.next++
+	}
+	return token
+}
+
+// accept consumes the next token if it is one of the given keywords or operators
+func (p *cselParser) accept(values ...string) bool {
+	token := p.peek()
+	if token.kind != cselOperator && token.kind != cselIdentifier {
+		return false
+	}
+	for _, value := range values {
+		if token.value == value {
+			p.next++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *cselParser) parseOr() (bool, error) {
+	matched, err := p.parseAnd()
+	if err != nil {
+		return false, err
+	}
+	for p.accept("or", "||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return false, err
+		}
+		matched = matched || right
+	}
+	return matched, nil
+}
+
+func (p *cselParser) parseAnd() (bool, error) {
+	matched, err := p.parseUnary()
+	if err != nil {
+		return false, err
+	}
+	for p.accept("and", "&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return false, err
+		}
+		matched = matched && right
+	}
+	return matched, nil
+}
+
+func (p *cselParser) parseUnary() (bool, error) {
+	if p.accept("not", "!") {
+		matched, err := p.parseUnary()
+		return !matched, err
+	}
+	if p.accept("(") {
+		matched, err := p.parseOr()
+		if err != nil {
+			return false, err
+		}
+		if !p.accept(")") {
+			token := p.peek()
+			return false, fmt.Errorf("expected \")\" at position %d", token.pos)
+		}
+		return matched, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *cselParser) parseComparison() (bool, error) {
+	identifier := p.consume()
+	if identifier.kind != cselIdentifier {
+		return false, fmt.Errorf("expected a variable at position %d", identifier.pos)
+	}
+	value, ok := p.variables[identifier.value]
+	if !ok {
+		return false, fmt.Errorf("unknown variable %q at position %d", identifier.value, identifier.pos)
+	}
+
+	operator := p.consume()
+	if operator.kind != cselOperator {
+		return false, fmt.Errorf("expected an operator at position %d", operator.pos)
+	}
+	literal := p.consume()
+	if literal.kind != cselString {
+		return false, fmt.Errorf("expected a string at position %d", literal.pos)
+	}
+
+	switch operator.value {
+	case "==":
+		return value == literal.value, nil
+	case "!=":
+		return value != literal.value, nil
+	case "=^":
+		return strings.HasPrefix(value, literal.value), nil
+	case "=~":
+		// Like JEXL, the regular expression has to match the whole value
+		re, err := regexp.Compile("^(?:" + literal.value + ")$")
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression %q at position %d: %v", literal.value, literal.pos, err)
+		}
+		return re.MatchString(value), nil
+	}
+	return false, fmt.Errorf("unsupported operator %q at position %d", operator.value, operator.pos)
+}
@@ -0,0 +1,60 @@
+package security
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityContentSelectorTest() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to test whether a content selector expression matches a sample asset.
+
+This helps to debug complex expressions before they are used for content selector privileges. The expression is evaluated by the provider, Nexus is not called.`,
+
+		Read: dataSourceSecurityContentSelectorTestRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"expression": {
+				Description: "The content selector expression, e.g. `format == \"maven2\" and path =^ \"/org/example/\"`",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"format": {
+				Description: "The format of the sample asset, e.g. `maven2`",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"path": {
+				Description: "The path of the sample asset, e.g. `/org/example/app/1.0/app-1.0.jar`",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"matched": {
+				Computed:    true,
+				Description: "Whether the expression matches the sample asset",
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityContentSelectorTestRead(d *schema.ResourceData, m interface{}) error {
+	expression := d.Get("expression").(string)
+	format := d.Get("format").(string)
+	path := d.Get("path").(string)
+
+	matched, err := evaluateCSEL(expression, map[string]string{
+		"format": format,
+		"path":   path,
+	})
+	if err != nil {
+		return fmt.Errorf("evaluating content selector expression %q: %w", expression, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", format, path))
+	d.Set("matched", matched)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceSecurityContentSelectorTest(t *testing.T) {
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_content_selector_test"]
+	read := func(expression string, format string, path string) (*schema.ResourceData, error) {
+		resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+			"expression": expression,
+			"format":     format,
+			"path":       path,
+		})
+		return resourceData, res.Read(resourceData, nil)
+	}
+
+	for _, testCase := range []struct {
+		expression string
+		format     string
+		path       string
+		matched    bool
+	}{
+		{`format == "maven2"`, "maven2", "/org/example/app/1.0/app-1.0.jar", true},
+		{`format == "maven2"`, "npm", "/lodash", false},
+		{`format != 'npm'`, "maven2", "/org/example/app/1.0/app-1.0.jar", true},
+		{`format == "maven2" and path =^ "/org/example/"`, "maven2", "/org/example/app/1.0/app-1.0.jar", true},
+		{`format == "maven2" && path =^ "/org/example/"`, "maven2", "/com/example/app/1.0/app-1.0.jar", false},
+		{`path =~ "/org/example/.*\\.jar"`, "maven2", "/org/example/app/1.0/app-1.0.jar", true},
+		// The regular expression has to match the whole path
+		{`path =~ "/org/example"`, "maven2", "/org/example/app/1.0/app-1.0.jar", false},
+		{`format == "npm" or (format == "maven2" and not path =^ "/com/")`, "maven2", "/org/example/app/1.0/app-1.0.jar", true},
+		{`format == "npm" || !(format == "maven2")`, "maven2", "/org/example/app/1.0/app-1.0.jar", false},
+	} {
+		resourceData, err := read(testCase.expression, testCase.format, testCase.path)
+		assert.NoError(t, err, testCase.expression)
+		assert.Equal(t, testCase.matched, resourceData.Get("matched"), testCase.expression)
+	}
+
+	for _, testCase := range []struct {
+		expression  string
+		expectedErr string
+	}{
+		{`version == "1.0"`, `unknown variable "version" at position 0`},
+		{`format == "maven2`, `unterminated string starting at position 10`},
+		{`(format == "maven2"`, `expected ")" at position 19`},
+		{`format == "maven2" path == "/"`, `unexpected "path" at position 19`},
+		{`path =~ "[a-"`, `invalid regular expression "[a-" at position 8`},
+	} {
+		_, err := read(testCase.expression, "maven2", "/")
+		assert.ErrorContains(t, err, testCase.expectedErr, testCase.expression)
+	}
+}
@@ -1,10 +1,17 @@
 package security_test
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDataSourceSecurityLDAP(t *testing.T) {
@@ -30,3 +37,51 @@ func testAccDataSourceSecurityLDAPConfig() string {
 data "nexus_security_ldap" "acceptance" {}
 `
 }
+
+func TestDataSourceSecurityLDAPByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/ldap" {
+			fmt.Fprint(w, `[
+				{"id":"1","name":"corp","protocol":"ldaps","host":"ldap.example.com","port":636,"searchBase":"dc=example,dc=com","authScheme":"SIMPLE","authUsername":"cn=nexus","authPassword":"secret","userBaseDn":"ou=people","userIdAttribute":"uid","userObjectClass":"inetOrgPerson","userSubtree":true,"groupType":"static","groupSubtree":true,"ldapGroupsAsRoles":true},
+				{"id":"2","name":"backup","protocol":"ldap","host":"ldap2.example.com","port":389,"searchBase":"dc=example,dc=com"}
+			]`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_ldap"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "corp"})
+	err := res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "corp", resourceData.Id())
+	assert.Equal(t, "ldap.example.com", resourceData.Get("host"))
+	assert.Equal(t, 636, resourceData.Get("port"))
+	assert.Equal(t, "ldaps", resourceData.Get("protocol"))
+	assert.Equal(t, "dc=example,dc=com", resourceData.Get("search_base"))
+	assert.Equal(t, "uid", resourceData.Get("user_id_attribute"))
+	assert.Equal(t, true, resourceData.Get("group_subtree"))
+	assert.Equal(t, 1, resourceData.Get("ldap.#"))
+	for key, value := range resourceData.State().Attributes {
+		assert.NotContains(t, key, "auth_password")
+		assert.NotEqual(t, "secret", value)
+	}
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	err = res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "ldap", resourceData.Id())
+	assert.Equal(t, 2, resourceData.Get("ldap.#"))
+	assert.Equal(t, "backup", resourceData.Get("ldap.1.name"))
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "missing"})
+	err = res.Read(resourceData, nexusClient)
+	assert.EqualError(t, err, `reading LDAP server "missing": LDAP server not found`)
+}
@@ -0,0 +1,84 @@
+package security
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityLDAPUserMapping() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to test the user and group mapping of a LDAP configuration for a given user.",
+
+		Read: dataSourceSecurityLDAPUserMappingRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"ldap_name": {
+				Description: "Name of the LDAP configuration to test",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"username": {
+				Description: "The user ID to look up in the LDAP server",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"found": {
+				Computed:    true,
+				Description: "Whether the user was found by the user mapping",
+				Type:        schema.TypeBool,
+			},
+			"real_name": {
+				Computed:    true,
+				Description: "The real name of the user",
+				Type:        schema.TypeString,
+			},
+			"email": {
+				Computed:    true,
+				Description: "The email address of the user",
+				Type:        schema.TypeString,
+			},
+			"groups": {
+				Computed:    true,
+				Description: "The groups the user is mapped to",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityLDAPUserMappingRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	ldapName := d.Get("ldap_name").(string)
+	username := d.Get("username").(string)
+
+	ldap, err := client.Security.LDAP.Get(ldapName)
+	if err != nil {
+		return fmt.Errorf("reading LDAP server %q: %w", ldapName, err)
+	}
+
+	user, err := api.NewSecurityLDAPService(client).VerifyUserMapping(*ldap, username)
+	if err != nil {
+		return fmt.Errorf("reading user mapping of LDAP server %q: %w", ldapName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", ldapName, username))
+	if user == nil {
+		d.Set("found", false)
+		d.Set("real_name", "")
+		d.Set("email", "")
+		d.Set("groups", []string{})
+		return nil
+	}
+
+	d.Set("found", true)
+	d.Set("real_name", user.RealName)
+	d.Set("email", user.Email)
+	d.Set("groups", user.Membership)
+
+	return nil
+}
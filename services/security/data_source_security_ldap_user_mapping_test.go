@@ -0,0 +1,84 @@
+package security_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testLDAPUserMappingServer(t *testing.T, users string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/ldap/acceptance":
+			fmt.Fprint(w, `{"id":"ldap-id","name":"acceptance","protocol":"LDAP","host":"127.0.0.1","port":389,"searchBase":"dc=example,dc=com","authScheme":"SIMPLE","authUsername":"admin","userIdAttribute":"uid","userLdapFilter":"(objectClass=person)","groupType":"dynamic","userMemberOfAttribute":"memberOf"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/service/extdirect":
+			var request struct {
+				Method string                   `json:"method"`
+				Data   []map[string]interface{} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			assert.Equal(t, "verifyUserMapping", request.Method)
+			assert.Equal(t, "ldap-id", request.Data[0]["id"])
+			assert.Equal(t, "(&(objectClass=person)(uid=jdoe))", request.Data[0]["userLdapFilter"])
+			fmt.Fprintf(w, `{"tid":1,"action":"ldap_LdapServer","method":"verifyUserMapping","type":"rpc","result":{"success":true,"data":%s}}`, users)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestDataSourceSecurityLDAPUserMapping(t *testing.T) {
+	server := testLDAPUserMappingServer(t, `[{"username":"jdoe","realName":"John Doe","email":"jdoe@example.com","membership":["developers","admins"]}]`)
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_ldap_user_mapping"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"ldap_name": "acceptance",
+		"username":  "jdoe",
+	})
+
+	err := res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, "acceptance/jdoe", resourceData.Id())
+	assert.Equal(t, true, resourceData.Get("found"))
+	assert.Equal(t, "John Doe", resourceData.Get("real_name"))
+	assert.Equal(t, "jdoe@example.com", resourceData.Get("email"))
+	assert.Equal(t, []interface{}{"developers", "admins"}, resourceData.Get("groups"))
+}
+
+func TestDataSourceSecurityLDAPUserMappingUserNotFound(t *testing.T) {
+	server := testLDAPUserMappingServer(t, `[]`)
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_ldap_user_mapping"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"ldap_name": "acceptance",
+		"username":  "jdoe",
+	})
+
+	err := res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, false, resourceData.Get("found"))
+	assert.Empty(t, resourceData.Get("groups"))
+}
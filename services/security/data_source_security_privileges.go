@@ -0,0 +1,88 @@
+package security
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceSecurityPrivileges() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to discover the privileges of Nexus, e.g. the names of built-in privileges to attach to roles.",
+
+		Read: dataSourceSecurityPrivilegesRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"type": {
+				Description:  "Only return privileges of this type. Possible values: `application`, `repository-admin`, `repository-content-selector`, `repository-view`, `script` or `wildcard`",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(security.PrivilegeTypes, false),
+			},
+			"privileges": {
+				Computed:    true,
+				Description: "List of privileges",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Computed:    true,
+							Description: "The name of the privilege",
+							Type:        schema.TypeString,
+						},
+						"type": {
+							Computed:    true,
+							Description: "The type of the privilege",
+							Type:        schema.TypeString,
+						},
+						"description": {
+							Computed:    true,
+							Description: "A description of the privilege",
+							Type:        schema.TypeString,
+						},
+						"read_only": {
+							Computed:    true,
+							Description: "Whether the privilege is built-in and cannot be changed",
+							Type:        schema.TypeBool,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityPrivilegesRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	privilegeType := d.Get("type").(string)
+
+	// The privileges API returns all privileges at once
+	privileges, err := client.Security.Privilege.List()
+	if err != nil {
+		return fmt.Errorf("reading privileges: %w", err)
+	}
+
+	items := []map[string]interface{}{}
+	for _, privilege := range privileges {
+		if privilegeType != "" && privilege.Type != privilegeType {
+			continue
+		}
+		items = append(items, map[string]interface{}{
+			"name":        privilege.Name,
+			"type":        privilege.Type,
+			"description": privilege.Description,
+			"read_only":   privilege.ReadOnly,
+		})
+	}
+	if err := d.Set("privileges", items); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("privileges/%s", privilegeType))
+	return nil
+}
@@ -0,0 +1,78 @@
+package security_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceSecurityPrivileges(t *testing.T) {
+	dataSourceName := "data.nexus_security_privileges.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nexus_security_privileges" "acceptance" {
+	type = "wildcard"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "privileges.*", map[string]string{
+						"name":      "nx-all",
+						"type":      "wildcard",
+						"read_only": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceSecurityPrivilegesTypeFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/service/rest/v1/security/privileges" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"type":"wildcard","name":"nx-all","description":"All permissions","readOnly":true,"pattern":"nexus:*"},
+			{"type":"application","name":"nx-blobstores-all","description":"All permissions for Blobstores","readOnly":true,"domain":"blobstores","actions":["ALL"]},
+			{"type":"wildcard","name":"custom","description":"Custom","readOnly":false,"pattern":"nexus:custom:*"}
+		]`)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_privileges"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	err := res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, resourceData.Get("privileges.#"))
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"type": "wildcard",
+	})
+	err = res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resourceData.Get("privileges.#"))
+	assert.Equal(t, "nx-all", resourceData.Get("privileges.0.name"))
+	assert.Equal(t, "All permissions", resourceData.Get("privileges.0.description"))
+	assert.Equal(t, true, resourceData.Get("privileges.0.read_only"))
+	assert.Equal(t, "custom", resourceData.Get("privileges.1.name"))
+	assert.Equal(t, false, resourceData.Get("privileges.1.read_only"))
+}
@@ -1,7 +1,7 @@
 /*
 Use this data source to list all security realms.
 
-Example Usage
+# Example Usage
 
 ```hcl
 data "nexus_security_realms" "default" {}
@@ -10,8 +10,8 @@ data "nexus_security_realms" "default" {}
 package security
 
 import (
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -66,7 +66,7 @@ func DataSourceSecurityRealms() *schema.Resource {
 }
 
 func dataSourceRealmsRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	availableRealms, err := client.Security.Realm.ListAvailable()
 	if err != nil {
@@ -1,9 +1,13 @@
 package security
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -51,12 +55,94 @@ func DataSourceSecurityRole() *schema.Resource {
 				},
 				Type: schema.TypeSet,
 			},
+			"effective_privileges": {
+				Description: "The privileges this role actually grants, including those of all directly and indirectly contained roles.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+				Set: func(v interface{}) int {
+					return schema.HashString(strings.ToLower(v.(string)))
+				},
+				Type: schema.TypeSet,
+			},
+			"effective_roles": {
+				Description: "All roles this role contains directly or indirectly.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+				Set: func(v interface{}) int {
+					return schema.HashString(strings.ToLower(v.(string)))
+				},
+				Type: schema.TypeSet,
+			},
 		},
 	}
 }
 
 func dataSourceSecurityRoleRead(d *schema.ResourceData, m interface{}) error {
-	d.SetId(d.Get("roleid").(string))
+	client := m.(*api.Client)
+	roleID := d.Get("roleid").(string)
+
+	role, err := client.Security.Role.Get(roleID)
+	if err != nil {
+		return fmt.Errorf("reading role %q: %w", roleID, err)
+	}
 
-	return resourceSecurityRoleRead(d, m)
+	privileges, roles, err := resolveEffectiveRole(client, role.ID, role.Privileges, role.Roles)
+	if err != nil {
+		return fmt.Errorf("resolving effective privileges of role %q: %w", roleID, err)
+	}
+
+	d.SetId(role.ID)
+	d.Set("description", role.Description)
+	d.Set("name", role.Name)
+	d.Set("privileges", tools.StringSliceToInterfaceSlice(role.Privileges))
+	d.Set("roleid", role.ID)
+	d.Set("roles", tools.StringSliceToInterfaceSlice(role.Roles))
+	d.Set("effective_privileges", tools.StringSliceToInterfaceSlice(privileges))
+	d.Set("effective_roles", tools.StringSliceToInterfaceSlice(roles))
+
+	return nil
+}
+
+// resolveEffectiveRole follows the contained roles of a role transitively and
+// returns the union of their privileges and all visited roles. Each role is
+// read once, so cyclic role hierarchies terminate.
+func resolveEffectiveRole(client *api.Client, roleID string, privileges []string, roles []string) ([]string, []string, error) {
+	visited := map[string]bool{roleID: true}
+	effectivePrivileges := map[string]bool{}
+	for _, privilege := range privileges {
+		effectivePrivileges[privilege] = true
+	}
+
+	queue := append([]string{}, roles...)
+	effectiveRoles := []string{}
+	for len(queue) > 0 {
+		nestedRoleID := queue[0]
+		queue = queue[1:]
+		if visited[nestedRoleID] {
+			continue
+		}
+		visited[nestedRoleID] = true
+		effectiveRoles = append(effectiveRoles, nestedRoleID)
+
+		nestedRole, err := client.Security.Role.Get(nestedRoleID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading contained role %q: %w", nestedRoleID, err)
+		}
+		for _, privilege := range nestedRole.Privileges {
+			effectivePrivileges[privilege] = true
+		}
+		queue = append(queue, nestedRole.Roles...)
+	}
+
+	result := make([]string, 0, len(effectivePrivileges))
+	for privilege := range effectivePrivileges {
+		result = append(result, privilege)
+	}
+	sort.Strings(result)
+	sort.Strings(effectiveRoles)
+	return result, effectiveRoles, nil
 }
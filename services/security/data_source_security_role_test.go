@@ -1,13 +1,22 @@
 package security_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDataSourceSecurityRole(t *testing.T) {
@@ -34,6 +43,8 @@ func TestAccDataSourceSecurityRole(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "description", role.Description),
 					resource.TestCheckResourceAttr(dataSourceName, "privileges.#", strconv.Itoa(len(role.Privileges))),
 					resource.TestCheckResourceAttr(dataSourceName, "roles.#", strconv.Itoa(len(role.Roles))),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "effective_privileges.*", "nx-all"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "effective_roles.*", "nx-admin"),
 				),
 			},
 		},
@@ -47,3 +58,72 @@ data "nexus_security_role" "acceptance" {
 }
 `
 }
+
+func TestDataSourceSecurityRoleEffectivePrivileges(t *testing.T) {
+	// team-a and team-b contain each other
+	roles := map[string]security.Role{
+		"auditor":      {ID: "auditor", Name: "auditor", Privileges: []string{"nx-search-read"}, Roles: []string{"team-a", "nx-anonymous"}},
+		"team-a":       {ID: "team-a", Name: "team-a", Privileges: []string{"nx-repository-view-maven2-*-read"}, Roles: []string{"team-b"}},
+		"team-b":       {ID: "team-b", Name: "team-b", Privileges: []string{"nx-repository-view-npm-*-read", "nx-search-read"}, Roles: []string{"team-a", "auditor"}},
+		"nx-anonymous": {ID: "nx-anonymous", Name: "nx-anonymous", Privileges: []string{"nx-healthcheck-read"}, Roles: []string{}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		role, ok := roles[strings.TrimPrefix(r.URL.Path, "/service/rest/v1/security/roles/")]
+		if r.Method != http.MethodGet || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(role); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_role"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"roleid": "auditor"})
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, "auditor", resourceData.Id())
+	assert.ElementsMatch(t, []interface{}{"nx-search-read"}, resourceData.Get("privileges").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{
+		"nx-healthcheck-read",
+		"nx-repository-view-maven2-*-read",
+		"nx-repository-view-npm-*-read",
+		"nx-search-read",
+	}, resourceData.Get("effective_privileges").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"nx-anonymous", "team-a", "team-b"}, resourceData.Get("effective_roles").(*schema.Set).List())
+}
+
+func TestDataSourceSecurityRoleRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/roles/ui-role":
+			fmt.Fprint(w, `{"id":"ui-role","name":"UI role","description":"Created in the UI","privileges":["nx-ui-a","nx-ui-b"],"roles":["nx-anonymous"]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/roles/nx-anonymous":
+			fmt.Fprint(w, `{"id":"nx-anonymous","name":"nx-anonymous","privileges":[],"roles":[]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_role"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"roleid": "ui-role"})
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, "ui-role", resourceData.Id())
+	assert.Equal(t, "UI role", resourceData.Get("name"))
+	assert.Equal(t, "Created in the UI", resourceData.Get("description"))
+	assert.ElementsMatch(t, []interface{}{"nx-ui-a", "nx-ui-b"}, resourceData.Get("privileges").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"nx-anonymous"}, resourceData.Get("roles").(*schema.Set).List())
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func testAccDataSourceSecuritySAML() (*security.SAML, error) {
-	dat, err := ioutil.ReadFile("../../../examples/saml-testconfig.xml")
+	dat, err := ioutil.ReadFile("../../examples/saml-testconfig.xml")
 	if err != nil {
 		return nil, err
 	}
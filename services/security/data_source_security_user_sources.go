@@ -0,0 +1,62 @@
+package security
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityUserSources() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list the sources of users Nexus knows about, e.g. to filter the nexus_security_users data source by `source`.",
+
+		Read: dataSourceSecurityUserSourcesRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"sources": {
+				Computed:    true,
+				Description: "List of user sources",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed:    true,
+							Description: "The ID of the source, which is the `source` of its users, e.g. `default` or `LDAP`",
+							Type:        schema.TypeString,
+						},
+						"name": {
+							Computed:    true,
+							Description: "The name of the source, e.g. `Local` or `LDAP`",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityUserSourcesRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	sources, err := api.NewSecurityUsersService(client).ListSources()
+	if err != nil {
+		return fmt.Errorf("reading user sources: %w", err)
+	}
+
+	items := make([]map[string]interface{}, len(sources))
+	for i, source := range sources {
+		items[i] = map[string]interface{}{
+			"id":   source.ID,
+			"name": source.Name,
+		}
+	}
+	if err := d.Set("sources", items); err != nil {
+		return err
+	}
+
+	d.SetId("security-user-sources")
+	return nil
+}
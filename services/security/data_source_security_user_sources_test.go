@@ -0,0 +1,58 @@
+package security_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceSecurityUserSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nexus_security_user_sources" "acceptance" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.nexus_security_user_sources.acceptance", "sources.*", map[string]string{
+						"id":   "default",
+						"name": "Local",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceSecurityUserSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/service/rest/v1/security/user-sources" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"default","name":"Local"},{"id":"LDAP","name":"LDAP"},{"id":"Crowd","name":"Crowd"}]`)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_user_sources"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, 3, resourceData.Get("sources.#"))
+	assert.Equal(t, "default", resourceData.Get("sources.0.id"))
+	assert.Equal(t, "Local", resourceData.Get("sources.0.name"))
+	assert.Equal(t, "Crowd", resourceData.Get("sources.2.id"))
+}
@@ -0,0 +1,96 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get a list of users, optionally filtered by source and user ID.",
+
+		Read: dataSourceSecurityUsersRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"source": {
+				Description: "Only return users of this source, e.g. `default` or `LDAP`",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"userid": {
+				Description: "Only return users whose user ID contains this string",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"users": {
+				Computed:    true,
+				Description: "List of users",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"userid": {
+							Computed:    true,
+							Description: "The userid which is required for login",
+							Type:        schema.TypeString,
+						},
+						"source": {
+							Computed:    true,
+							Description: "The source of the user, e.g. default or LDAP",
+							Type:        schema.TypeString,
+						},
+						"status": {
+							Computed:    true,
+							Description: "The user's status, e.g. active or disabled.",
+							Type:        schema.TypeString,
+						},
+						"roles": {
+							Computed:    true,
+							Description: "The roles which the user has been assigned within Nexus.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeSet,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityUsersRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	source := d.Get("source").(string)
+	userID := d.Get("userid").(string)
+
+	users, err := api.NewSecurityUsersService(client).List(source)
+	if err != nil {
+		return fmt.Errorf("reading users: %w", err)
+	}
+
+	items := []map[string]interface{}{}
+	for _, user := range users {
+		if source != "" && !strings.EqualFold(user.Source, source) {
+			continue
+		}
+		if userID != "" && !strings.Contains(strings.ToLower(user.UserID), strings.ToLower(userID)) {
+			continue
+		}
+		items = append(items, map[string]interface{}{
+			"userid": user.UserID,
+			"source": user.Source,
+			"status": user.Status,
+			"roles":  tools.StringSliceToInterfaceSlice(user.Roles),
+		})
+	}
+	if err := d.Set("users", items); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", source, userID))
+	return nil
+}
@@ -0,0 +1,78 @@
+package security_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceSecurityUsers(t *testing.T) {
+	// 150 LDAP users, which exceed a single page of 100 users, and one local user
+	directory := []security.User{{UserID: "admin", Source: "default", Status: "active", Roles: []string{"nx-admin"}}}
+	for i := 0; i < 150; i++ {
+		directory = append(directory, security.User{UserID: fmt.Sprintf("user%03d", i), Source: "LDAP", Status: "active", Roles: []string{"developers"}})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/service/rest/v1/security/users" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		source := r.URL.Query().Get("source")
+		prefix := r.URL.Query().Get("userId")
+
+		page := []security.User{}
+		for _, user := range directory {
+			if (source == "" || user.Source == source) && strings.HasPrefix(user.UserID, prefix) && len(page) < 100 {
+				page = append(page, user)
+			}
+		}
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.DataSourcesMap["nexus_security_users"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"source": "LDAP",
+	})
+	err := res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, 150, resourceData.Get("users.#"))
+	assert.Equal(t, "LDAP", resourceData.Get("users.0.source"))
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"userid": "14",
+	})
+	err = res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, resourceData.Get("users.#"))
+	assert.Equal(t, "user014", resourceData.Get("users.0.userid"))
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"source": "default",
+	})
+	err = res.Read(resourceData, nexusClient)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resourceData.Get("users.#"))
+	assert.Equal(t, "admin", resourceData.Get("users.0.userid"))
+	assert.Equal(t, "active", resourceData.Get("users.0.status"))
+	assert.Equal(t, 1, resourceData.Get("users.0.roles.#"))
+}
@@ -1,8 +1,10 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -27,21 +29,30 @@ func ResourceSecurityAnonymous() *schema.Resource {
 				Default:     false,
 			},
 			"user_id": {
-				Description: "The user id used by anonymous access. Default: \"anonymous\"",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "anonymous",
+				Description:      "The user id used by anonymous access. Ignored while anonymous access is disabled. Default: \"anonymous\"",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "anonymous",
+				DiffSuppressFunc: suppressWhenAnonymousDisabled,
 			},
 			"realm_name": {
-				Description: "The name of the used realm. Default: \"NexusAuthorizingRealm\"",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "NexusAuthorizingRealm",
+				Description:      "The name of the used realm. Ignored while anonymous access is disabled. Default: \"NexusAuthorizingRealm\"",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "NexusAuthorizingRealm",
+				DiffSuppressFunc: suppressWhenAnonymousDisabled,
 			},
 		},
 	}
 }
 
+// suppressWhenAnonymousDisabled ignores the user and realm of disabled
+// anonymous access. They have no effect then, and Nexus may return other
+// values than the defaults of the resource.
+func suppressWhenAnonymousDisabled(k, old, new string, d *schema.ResourceData) bool {
+	return !d.Get("enabled").(bool)
+}
+
 func getAnonymousFromResourceData(d *schema.ResourceData) security.AnonymousAccessSettings {
 	return security.AnonymousAccessSettings{
 		Enabled:   d.Get("enabled").(bool),
@@ -59,22 +70,22 @@ func setAnonymousToResourceData(anonymous *security.AnonymousAccessSettings, d *
 }
 
 func resourceSecurityAnonymousRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	anonymous, err := client.Security.Anonymous.Read()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading anonymous access configuration: %w", err)
 	}
 
 	return setAnonymousToResourceData(anonymous, d)
 }
 
 func resourceSecurityAnonymousUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	anonymous := getAnonymousFromResourceData(d)
 	if err := client.Security.Anonymous.Update(anonymous); err != nil {
-		return err
+		return fmt.Errorf("updating anonymous access configuration: %w", err)
 	}
 
 	return resourceSecurityAnonymousRead(d, m)
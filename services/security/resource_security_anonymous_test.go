@@ -1,6 +1,7 @@
 package security_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"testing"
@@ -8,6 +9,8 @@ import (
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceSecurityAnonymous(t *testing.T) {
@@ -44,3 +47,31 @@ resource "nexus_security_anonymous" "acceptance" {
 }
 `, anonym.Enabled, anonym.UserID, anonym.RealmName)
 }
+
+func TestResourceSecurityAnonymousDisabledDiff(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_anonymous"]
+	// Nexus returns another user and realm than the defaults of the resource
+	state := &terraform.InstanceState{
+		ID: "anonymous",
+		Attributes: map[string]string{
+			"id":         "anonymous",
+			"enabled":    "false",
+			"user_id":    "nx-anonymous",
+			"realm_name": "LdapRealm",
+		},
+	}
+	diff := func(config map[string]interface{}) *terraform.InstanceDiff {
+		diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		assert.NoError(t, err)
+		return diff
+	}
+
+	assert.Nil(t, diff(map[string]interface{}{"enabled": false}))
+	assert.Nil(t, diff(map[string]interface{}{"enabled": false, "user_id": "anonymous"}))
+
+	enabled := diff(map[string]interface{}{"enabled": true})
+	if assert.NotNil(t, enabled) {
+		assert.Equal(t, "anonymous", enabled.Attributes["user_id"].New)
+		assert.Equal(t, "NexusAuthorizingRealm", enabled.Attributes["realm_name"].New)
+	}
+}
@@ -1,8 +1,10 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -63,12 +65,12 @@ func setContentSelectorToResourceData(contentSelector *security.ContentSelector,
 }
 
 func resourceSecurityContentSelectorCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	contentSelector := getContentSelectorFromResourceData(d)
 
 	if err := client.Security.ContentSelector.Create(contentSelector); err != nil {
-		return err
+		return fmt.Errorf("creating content selector %q: %w", contentSelector.Name, err)
 	}
 
 	d.SetId(contentSelector.Name)
@@ -77,11 +79,11 @@ func resourceSecurityContentSelectorCreate(d *schema.ResourceData, m interface{}
 }
 
 func resourceSecurityContentSelectorRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	contentSelector, err := client.Security.ContentSelector.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading content selector %q: %w", d.Id(), err)
 	}
 
 	if contentSelector == nil {
@@ -93,21 +95,21 @@ func resourceSecurityContentSelectorRead(d *schema.ResourceData, m interface{})
 }
 
 func resourceSecurityContentSelectorUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	contentSelector := getContentSelectorFromResourceData(d)
 	if err := client.Security.ContentSelector.Update(d.Id(), contentSelector); err != nil {
-		return err
+		return fmt.Errorf("updating content selector %q: %w", d.Id(), err)
 	}
 
 	return resourceSecurityContentSelectorRead(d, m)
 }
 
 func resourceSecurityContentSelectorDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.Security.ContentSelector.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting content selector %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -116,7 +118,7 @@ func resourceSecurityContentSelectorDelete(d *schema.ResourceData, m interface{}
 }
 
 func resourceSecurityContentSelectorExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	contentSelector, err := client.Security.ContentSelector.Get(d.Id())
 	return contentSelector != nil, err
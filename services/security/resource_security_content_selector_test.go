@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -63,7 +63,7 @@ func testAccCheckContentSelectorResourceExists(name string, contentSelector *sec
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		client := acceptance.TestAccProvider.Meta().(*nexus.NexusClient)
+		client := acceptance.TestAccProvider.Meta().(*api.Client)
 		result, err := client.Security.ContentSelector.Get(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -0,0 +1,168 @@
+package security
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	crowdCapabilityTypeID = "crowd"
+)
+
+func ResourceSecurityCrowd() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to configure the Atlassian Crowd integration of Nexus.
+
+This resource manages the "Crowd" capability of Nexus. There is only one Crowd configuration, destroying the resource removes it.
+Enable the ` + "`Crowd`" + ` realm with the nexus_security_realms resource to authenticate users against Crowd.`,
+
+		Create: resourceSecurityCrowdCreate,
+		Read:   resourceSecurityCrowdRead,
+		Update: resourceSecurityCrowdUpdate,
+		Delete: resourceSecurityCrowdDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"enabled": {
+				Default:     true,
+				Description: "Whether the Crowd integration is enabled",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"url": {
+				Description:  "The URL of the Crowd server, e.g. `https://crowd.example.com/crowd`",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"application_name": {
+				Description: "The name of the application Nexus authenticates as in Crowd",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"application_password": {
+				Description: "The password of the application in Crowd. Nexus never returns it, so changes made outside of Terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"timeout": {
+				Default:      15,
+				Description:  "The connection and socket timeout in seconds",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func getSecurityCrowdCapabilityFromResourceData(d *schema.ResourceData) api.Capability {
+	return api.Capability{
+		TypeID:  crowdCapabilityTypeID,
+		Enabled: d.Get("enabled").(bool),
+		Properties: map[string]string{
+			"applicationName":     d.Get("application_name").(string),
+			"applicationPassword": d.Get("application_password").(string),
+			"crowdServerUrl":      d.Get("url").(string),
+			"timeout":             strconv.Itoa(d.Get("timeout").(int)),
+		},
+	}
+}
+
+func resourceSecurityCrowdCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewCapabilityService(client)
+
+	existing, err := service.GetByType(crowdCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("creating Crowd configuration: %w", err)
+	}
+
+	// There can only be one Crowd configuration, take over an existing one
+	capability := getSecurityCrowdCapabilityFromResourceData(d)
+	if existing != nil {
+		capability.ID = existing.ID
+		if err := service.Update(capability); err != nil {
+			return fmt.Errorf("creating Crowd configuration: %w", err)
+		}
+	} else if _, err := service.Create(capability); err != nil {
+		return fmt.Errorf("creating Crowd configuration: %w", err)
+	}
+
+	d.SetId(crowdCapabilityTypeID)
+	return resourceSecurityCrowdRead(d, m)
+}
+
+func resourceSecurityCrowdRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	capability, err := api.NewCapabilityService(client).GetByType(crowdCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("reading Crowd configuration: %w", err)
+	}
+
+	if capability == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(crowdCapabilityTypeID)
+	d.Set("enabled", capability.Enabled)
+	d.Set("url", capability.Properties["crowdServerUrl"])
+	d.Set("application_name", capability.Properties["applicationName"])
+	if timeout, err := strconv.Atoi(capability.Properties["timeout"]); err == nil {
+		d.Set("timeout", timeout)
+	}
+
+	return nil
+}
+
+func resourceSecurityCrowdUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewCapabilityService(client)
+
+	existing, err := service.GetByType(crowdCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("updating Crowd configuration: %w", err)
+	}
+	if existing == nil {
+		return resourceSecurityCrowdCreate(d, m)
+	}
+
+	capability := getSecurityCrowdCapabilityFromResourceData(d)
+	capability.ID = existing.ID
+	if err := service.Update(capability); err != nil {
+		return fmt.Errorf("updating Crowd configuration: %w", err)
+	}
+
+	return resourceSecurityCrowdRead(d, m)
+}
+
+func resourceSecurityCrowdDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	service := api.NewCapabilityService(client)
+
+	capability, err := service.GetByType(crowdCapabilityTypeID)
+	if err != nil {
+		return fmt.Errorf("deleting Crowd configuration: %w", err)
+	}
+	if capability == nil {
+		return nil
+	}
+
+	if err := service.Delete(capability.ID); err != nil {
+		return fmt.Errorf("deleting Crowd configuration: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/internal/testhelper"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceSecurityCrowdToggleOn(t *testing.T) {
+	// Crowd was configured by hand and disabled
+	capabilities := []api.Capability{
+		{ID: "capability-1", TypeID: "crowd", Enabled: false, Properties: map[string]string{"crowdServerUrl": "https://old.example.com/crowd"}},
+	}
+	server := testhelper.FakeCapabilityServer(&capabilities)
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_crowd"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"url":                  "https://crowd.example.com/crowd",
+		"application_name":     "nexus",
+		"application_password": "secret",
+	})
+	assert.NoError(t, res.Create(resourceData, nexusClient))
+	assert.Equal(t, "crowd", resourceData.Id())
+	assert.Len(t, capabilities, 1)
+	assert.Equal(t, "capability-1", capabilities[0].ID)
+	assert.True(t, capabilities[0].Enabled)
+	assert.Equal(t, map[string]string{
+		"applicationName":     "nexus",
+		"applicationPassword": "secret",
+		"crowdServerUrl":      "https://crowd.example.com/crowd",
+		"timeout":             "15",
+	}, capabilities[0].Properties)
+
+	// Non-secret changes made in Nexus are reflected, the password never is
+	capabilities[0].Properties["applicationName"] = "nexus-prod"
+	capabilities[0].Properties["applicationPassword"] = "#~NXRM~PLACEHOLDER~PASSWORD~#"
+	assert.NoError(t, res.Read(resourceData, nexusClient))
+	assert.Equal(t, "nexus-prod", resourceData.Get("application_name"))
+	assert.Equal(t, "secret", resourceData.Get("application_password"))
+
+	assert.NoError(t, res.Delete(resourceData, nexusClient))
+	assert.Empty(t, capabilities)
+}
@@ -1,8 +1,10 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -177,32 +179,42 @@ func ResourceSecurityLDAP() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeBool,
 			},
+			"verify_on_apply": {
+				Default:     false,
+				Description: "Verify that Nexus can connect and bind to the LDAP server after create and update. The apply fails with the diagnostic of the server if the verification fails",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 		},
 	}
 }
 
 func resourceSecurityLDAPCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	ldap := getSecurityLDAPFromResourceData(d)
 
 	if err := client.Security.LDAP.Create(ldap); err != nil {
-		return err
+		return fmt.Errorf("creating LDAP server %q: %w", ldap.Name, err)
 	}
 
 	if err := setSecurityLDAPToResourceData(&ldap, d); err != nil {
 		return err
 	}
 
+	if err := verifySecurityLDAPConnection(client, ldap, d); err != nil {
+		return err
+	}
+
 	return resourceSecurityLDAPRead(d, m)
 }
 
 func resourceSecurityLDAPRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	ldap, err := client.Security.LDAP.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading LDAP server %q: %w", d.Id(), err)
 	}
 
 	if ldap == nil {
@@ -214,26 +226,47 @@ func resourceSecurityLDAPRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSecurityLDAPUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	ldapID := d.Id()
 	ldap := getSecurityLDAPFromResourceData(d)
 
 	if err := client.Security.LDAP.Update(ldapID, ldap); err != nil {
-		return err
+		return fmt.Errorf("updating LDAP server %q: %w", ldapID, err)
 	}
 
 	if err := setSecurityLDAPToResourceData(&ldap, d); err != nil {
 		return err
 	}
 
+	if err := verifySecurityLDAPConnection(client, ldap, d); err != nil {
+		return err
+	}
+
 	return resourceSecurityLDAPRead(d, m)
 }
 
 func resourceSecurityLDAPDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
+
+	if err := client.Security.LDAP.Delete(d.Id()); err != nil {
+		return fmt.Errorf("deleting LDAP server %q: %w", d.Id(), err)
+	}
+	return nil
+}
 
-	return client.Security.LDAP.Delete(d.Id())
+// verifySecurityLDAPConnection checks the connection of the LDAP server if
+// verify_on_apply is set. The setting is never read back from Nexus, so the
+// verification does not take part in drift detection.
+func verifySecurityLDAPConnection(client *api.Client, ldap security.LDAP, d *schema.ResourceData) error {
+	if !d.Get("verify_on_apply").(bool) {
+		return nil
+	}
+
+	if err := api.NewSecurityLDAPService(client).VerifyConnection(ldap); err != nil {
+		return fmt.Errorf("verifying LDAP server %q: %w", ldap.Name, err)
+	}
+	return nil
 }
 
 func setSecurityLDAPToResourceData(ldap *security.LDAP, d *schema.ResourceData) error {
@@ -1,9 +1,11 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -31,10 +33,10 @@ func ResourceSecurityLDAPOrder() *schema.Resource {
 }
 
 func resourceSecurityLDAPOrderCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	order := tools.InterfaceSliceToStringSlice(d.Get("order").([]interface{}))
 	if err := client.Security.LDAP.ChangeOrder(order); err != nil {
-		return err
+		return fmt.Errorf("changing LDAP server order: %w", err)
 	}
 
 	d.SetId("change-order")
@@ -1,13 +1,20 @@
 package security_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceSecurityLDAP() security.LDAP {
@@ -77,8 +84,8 @@ func TestAccResourceSecurityLDAP(t *testing.T) {
 				ImportStateId:     ldap.Name,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// auth_password and group_type are not returned
-				ImportStateVerifyIgnore: []string{"auth_password", "group_type"},
+				// auth_password and group_type are not returned, verify_on_apply is never read
+				ImportStateVerifyIgnore: []string{"auth_password", "group_type", "verify_on_apply"},
 			},
 		},
 	})
@@ -106,3 +113,50 @@ resource "nexus_security_ldap" "%s" {
 }
 `, ldap.Name, ldap.AuthPassword, ldap.AuthSchema, ldap.AuthUserName, ldap.ConnectionRetryDelaySeconds, ldap.ConnectionTimeoutSeconds, ldap.GroupType, ldap.Host, ldap.MaxIncidentCount, ldap.Name, ldap.Port, ldap.Protocol, ldap.SearchBase, ldap.UserEmailAddressAttribute, ldap.UserIDAttribute, ldap.UserObjectClass, ldap.UserRealNameAttribute)
 }
+
+func TestResourceSecurityLDAPVerifyOnApplyFailure(t *testing.T) {
+	var verifyRequest map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/security/ldap":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPost && r.URL.Path == "/service/extdirect":
+			if err := json.NewDecoder(r.Body).Decode(&verifyRequest); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"tid":1,"action":"ldap_LdapServer","method":"verifyConnection","type":"rpc","result":{"success":false,"message":"Failed to connect to LDAP Server: Connection refused"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	ldap := testAccResourceSecurityLDAP()
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_ldap"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"auth_password":                  ldap.AuthPassword,
+		"auth_schema":                    ldap.AuthSchema,
+		"auth_username":                  ldap.AuthUserName,
+		"connection_retry_delay_seconds": int(ldap.ConnectionRetryDelaySeconds),
+		"connection_timeout_seconds":     int(ldap.ConnectionTimeoutSeconds),
+		"group_type":                     ldap.GroupType,
+		"host":                           ldap.Host,
+		"max_incident_count":             int(ldap.MaxIncidentCount),
+		"name":                           ldap.Name,
+		"port":                           int(ldap.Port),
+		"protocol":                       ldap.Protocol,
+		"search_base":                    ldap.SearchBase,
+		"verify_on_apply":                true,
+	})
+
+	err := res.Create(resourceData, nexusClient)
+	assert.Regexp(t, `^verifying LDAP server "acceptance": .*Failed to connect to LDAP Server: Connection refused$`, err)
+	assert.Equal(t, "ldap_LdapServer", verifyRequest["action"])
+	assert.Equal(t, "verifyConnection", verifyRequest["method"])
+}
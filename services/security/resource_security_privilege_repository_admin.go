@@ -0,0 +1,152 @@
+package security
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// repositoryAdminActions are the actions Nexus accepts for a
+// repository-admin privilege
+var repositoryAdminActions = []string{"ADD", "ALL", "BROWSE", "DELETE", "EDIT", "READ"}
+
+func ResourceSecurityPrivilegeRepositoryAdmin() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a Nexus repository admin privilege. Unlike a repository view privilege, which grants access to the content of a repository, it grants access to the configuration of a repository.",
+
+		Create: resourceSecurityPrivilegeRepositoryAdminCreate,
+		Read:   resourceSecurityPrivilegeRepositoryAdminRead,
+		Update: resourceSecurityPrivilegeRepositoryAdminUpdate,
+		Delete: resourceSecurityPrivilegeRepositoryAdminDelete,
+		Exists: resourceSecurityPrivilegeRepositoryAdminExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"name": {
+				Description: "The name of the privilege",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"description": {
+				Description: "A description of the privilege",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"format": {
+				Description:  "The repository format the privilege applies to, `*` for all formats",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(append([]string{"*"}, repository.RepositoryFormats...), false),
+			},
+			"repository": {
+				Description: "The name of the repository the privilege applies to, `*` for all repositories of the format",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"actions": {
+				Description: "The actions the privilege grants. Possible values: `ADD`, `ALL`, `BROWSE`, `DELETE`, `EDIT` and `READ`",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(repositoryAdminActions, false),
+				},
+				MinItems: 1,
+				Required: true,
+				Type:     schema.TypeSet,
+			},
+		},
+	}
+}
+
+func getPrivilegeRepositoryAdminFromResourceData(d *schema.ResourceData) security.Privilege {
+	return security.Privilege{
+		Actions:     tools.InterfaceSliceToStringSlice(d.Get("actions").(*schema.Set).List()),
+		Description: d.Get("description").(string),
+		Format:      d.Get("format").(string),
+		Name:        d.Get("name").(string),
+		Repository:  d.Get("repository").(string),
+		Type:        security.PrivilegeTypeRepositoryAdmin,
+	}
+}
+
+func setPrivilegeRepositoryAdminToResourceData(privilege *security.Privilege, d *schema.ResourceData) error {
+	d.SetId(privilege.Name)
+	d.Set("actions", privilege.Actions)
+	d.Set("description", privilege.Description)
+	d.Set("format", privilege.Format)
+	d.Set("name", privilege.Name)
+	d.Set("repository", privilege.Repository)
+	return nil
+}
+
+func resourceSecurityPrivilegeRepositoryAdminCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	privilege := getPrivilegeRepositoryAdminFromResourceData(d)
+
+	if err := client.Security.Privilege.Create(privilege); err != nil {
+		return fmt.Errorf("creating repository admin privilege %q: %w", privilege.Name, err)
+	}
+
+	d.SetId(privilege.Name)
+
+	return resourceSecurityPrivilegeRepositoryAdminRead(d, m)
+}
+
+func resourceSecurityPrivilegeRepositoryAdminRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	privilege, err := client.Security.Privilege.Get(d.Id())
+	if err != nil {
+		return fmt.Errorf("reading repository admin privilege %q: %w", d.Id(), err)
+	}
+
+	if privilege == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if privilege.Type != security.PrivilegeTypeRepositoryAdmin {
+		return fmt.Errorf("reading repository admin privilege %q: privilege is a %s privilege", d.Id(), privilege.Type)
+	}
+
+	return setPrivilegeRepositoryAdminToResourceData(privilege, d)
+}
+
+func resourceSecurityPrivilegeRepositoryAdminUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	privilege := getPrivilegeRepositoryAdminFromResourceData(d)
+	if err := client.Security.Privilege.Update(d.Id(), privilege); err != nil {
+		return fmt.Errorf("updating repository admin privilege %q: %w", d.Id(), err)
+	}
+
+	return resourceSecurityPrivilegeRepositoryAdminRead(d, m)
+}
+
+func resourceSecurityPrivilegeRepositoryAdminDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	if err := client.Security.Privilege.Delete(d.Id()); err != nil {
+		return fmt.Errorf("deleting repository admin privilege %q: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSecurityPrivilegeRepositoryAdminExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*api.Client)
+
+	privilege, err := client.Security.Privilege.Get(d.Id())
+	return privilege != nil, err
+}
@@ -0,0 +1,122 @@
+package security_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceSecurityPrivilegeRepositoryAdmin(t *testing.T) {
+	resName := "nexus_security_privilege_repository_admin.acceptance"
+	name := fmt.Sprintf("acceptance-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityPrivilegeRepositoryAdminConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", name),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "description", "Configure the docker repository"),
+					resource.TestCheckResourceAttr(resName, "format", "docker"),
+					resource.TestCheckResourceAttr(resName, "repository", name),
+					resource.TestCheckResourceAttr(resName, "actions.#", "2"),
+					resource.TestCheckResourceAttr("nexus_security_role.acceptance", "privileges.#", "1"),
+					resource.TestCheckTypeSetElemAttr("nexus_security_role.acceptance", "privileges.*", name),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityPrivilegeRepositoryAdminConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_docker_hosted" "acceptance" {
+	name = "%[1]s"
+
+	docker {
+		force_basic_auth = false
+		v1_enabled       = false
+	}
+
+	storage {
+		blob_store_name = "default"
+		write_policy    = "ALLOW"
+	}
+}
+
+resource "nexus_security_privilege_repository_admin" "acceptance" {
+	name        = "%[1]s"
+	description = "Configure the docker repository"
+	format      = "docker"
+	repository  = nexus_repository_docker_hosted.acceptance.name
+	actions     = ["BROWSE", "EDIT"]
+}
+
+resource "nexus_security_role" "acceptance" {
+	roleid     = "%[1]s"
+	name       = "%[1]s"
+	privileges = [nexus_security_privilege_repository_admin.acceptance.name]
+}
+`, name)
+}
+
+func TestResourceSecurityPrivilegeRepositoryAdminValidation(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_privilege_repository_admin"]
+	config := func(format string, actions ...interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":       "docker-admin",
+			"format":     format,
+			"repository": "docker-hosted",
+			"actions":    actions,
+		})
+	}
+
+	assert.Empty(t, res.Validate(config("docker", "BROWSE", "EDIT")))
+	assert.Empty(t, res.Validate(config("*", "ALL")))
+	assert.True(t, res.Validate(config("docker", "RUN")).HasError())
+	assert.True(t, res.Validate(config("docker", "browse")).HasError())
+	assert.True(t, res.Validate(config("docker")).HasError())
+	assert.True(t, res.Validate(config("unknown", "READ")).HasError())
+}
+
+func TestResourceSecurityPrivilegeRepositoryAdminReadOtherType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/privileges" {
+			fmt.Fprint(w, `[{"name":"docker-view","type":"repository-view","format":"docker","repository":"docker-hosted","actions":["READ"]}]`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_privilege_repository_admin"]
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	resourceData.SetId("docker-view")
+
+	err := res.Read(resourceData, nexusClient)
+	assert.EqualError(t, err, `reading repository admin privilege "docker-view": privilege is a repository-view privilege`)
+}
@@ -1,9 +1,11 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,20 +36,20 @@ func ResourceSecurityRealms() *schema.Resource {
 }
 
 func resourceRealmsCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	realmIDs := tools.InterfaceSliceToStringSlice(d.Get("active").([]interface{}))
 	if err := client.Security.Realm.Activate(realmIDs); err != nil {
-		return err
+		return fmt.Errorf("activating realms: %w", err)
 	}
 
 	return resourceRealmsRead(d, m)
 }
 
 func resourceRealmsRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	activeRealms, err := client.Security.Realm.ListActive()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading active realms: %w", err)
 	}
 
 	d.SetId("active")
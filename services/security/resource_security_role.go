@@ -1,11 +1,14 @@
 package security
 
 import (
+	"context"
+	"fmt"
+
 	"strings"
 
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,7 +23,7 @@ func ResourceSecurityRole() *schema.Resource {
 		Delete: resourceSecurityRoleDelete,
 		Exists: resourceSecurityRoleExists,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSecurityRoleImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,6 +66,12 @@ func ResourceSecurityRole() *schema.Resource {
 				},
 				Type: schema.TypeSet,
 			},
+			"exclusive": {
+				Default:     true,
+				Description: "Whether Terraform manages all privileges and roles of this role. If false, only the declared privileges and roles are managed: privileges and roles attached otherwise, e.g. in the UI, are left untouched and on destroy only the declared privileges and roles are detached while the role itself is kept. A role which already exists, e.g. one created in the UI, is adopted on create instead of failing.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 		},
 	}
 }
@@ -78,22 +87,58 @@ func getSecurityRoleFromResourceData(d *schema.ResourceData) security.Role {
 }
 
 func resourceSecurityRoleCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	role := getSecurityRoleFromResourceData(d)
+
+	if !d.Get("exclusive").(bool) {
+		adopted, err := adoptSecurityRole(client, role)
+		if err != nil {
+			return fmt.Errorf("creating role %q: %w", role.ID, err)
+		}
+		if adopted {
+			d.SetId(role.ID)
+			return resourceSecurityRoleRead(d, m)
+		}
+	}
+
 	if err := client.Security.Role.Create(role); err != nil {
-		return err
+		return fmt.Errorf("creating role %q: %w", role.ID, err)
 	}
 
 	d.SetId(role.ID)
 	return resourceSecurityRoleRead(d, m)
 }
 
+// adoptSecurityRole attaches the declared privileges and roles to an existing
+// role, e.g. one created in the UI, and keeps all others. It returns false if
+// the role does not exist yet.
+func adoptSecurityRole(client *api.Client, role security.Role) (bool, error) {
+	existingRoles, err := api.NewSecurityRolesService(client).List("default")
+	if err != nil {
+		return false, err
+	}
+	for _, existingRole := range existingRoles {
+		if existingRole.ID != role.ID {
+			continue
+		}
+
+		current, err := client.Security.Role.Get(role.ID)
+		if err != nil {
+			return false, err
+		}
+		role.Privileges = unionStringsFold(current.Privileges, role.Privileges)
+		role.Roles = unionStringsFold(current.Roles, role.Roles)
+		return true, client.Security.Role.Update(role.ID, role)
+	}
+	return false, nil
+}
+
 func resourceSecurityRoleRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	role, err := client.Security.Role.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading role %q: %w", d.Id(), err)
 	}
 
 	if role == nil {
@@ -101,32 +146,70 @@ func resourceSecurityRoleRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 
+	privileges := role.Privileges
+	roles := role.Roles
+	// The data source shares this read and has no exclusive flag
+	if exclusive, ok := d.Get("exclusive").(bool); ok && !exclusive {
+		// Only the declared privileges and roles are managed, ignore all others
+		privileges = intersectStringsFold(role.Privileges, tools.ConvertStringSet(d.Get("privileges").(*schema.Set)))
+		roles = intersectStringsFold(role.Roles, tools.ConvertStringSet(d.Get("roles").(*schema.Set)))
+	}
+
 	d.Set("description", role.Description)
 	d.Set("name", role.Name)
-	d.Set("privileges", tools.StringSliceToInterfaceSlice(role.Privileges))
+	d.Set("privileges", tools.StringSliceToInterfaceSlice(privileges))
 	d.Set("roleid", role.ID)
-	d.Set("roles", tools.StringSliceToInterfaceSlice(role.Roles))
+	d.Set("roles", tools.StringSliceToInterfaceSlice(roles))
 
 	return nil
 }
 
 func resourceSecurityRoleUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	roleID := d.Get("roleid").(string)
 
 	role := getSecurityRoleFromResourceData(d)
+	if !d.Get("exclusive").(bool) {
+		current, err := client.Security.Role.Get(roleID)
+		if err != nil {
+			return fmt.Errorf("updating role %q: %w", roleID, err)
+		}
+
+		oldPrivileges, _ := d.GetChange("privileges")
+		oldRoles, _ := d.GetChange("roles")
+		role.Privileges = unionStringsFold(subtractStringsFold(current.Privileges, tools.ConvertStringSet(oldPrivileges.(*schema.Set))), role.Privileges)
+		role.Roles = unionStringsFold(subtractStringsFold(current.Roles, tools.ConvertStringSet(oldRoles.(*schema.Set))), role.Roles)
+	}
+
 	if err := client.Security.Role.Update(roleID, role); err != nil {
-		return err
+		return fmt.Errorf("updating role %q: %w", roleID, err)
 	}
 
 	return resourceSecurityRoleRead(d, m)
 }
 
 func resourceSecurityRoleDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
+
+	if !d.Get("exclusive").(bool) {
+		current, err := client.Security.Role.Get(d.Id())
+		if err != nil {
+			return fmt.Errorf("deleting role %q: %w", d.Id(), err)
+		}
+
+		// Keep the role and detach the declared privileges and roles only
+		current.Privileges = subtractStringsFold(current.Privileges, tools.ConvertStringSet(d.Get("privileges").(*schema.Set)))
+		current.Roles = subtractStringsFold(current.Roles, tools.ConvertStringSet(d.Get("roles").(*schema.Set)))
+		if err := client.Security.Role.Update(d.Id(), *current); err != nil {
+			return fmt.Errorf("deleting role %q: %w", d.Id(), err)
+		}
+
+		d.SetId("")
+		return nil
+	}
 
 	if err := client.Security.Role.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting role %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -134,8 +217,58 @@ func resourceSecurityRoleDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSecurityRoleExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	role, err := client.Security.Role.Get(d.Id())
 	return role != nil, err
 }
+
+func resourceSecurityRoleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	// Imported roles are managed exclusively, as the declared privileges and
+	// roles are not known yet. Set exclusive to false instead to adopt an
+	// existing role on create without importing it.
+	d.Set("exclusive", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+// intersectStringsFold returns the values of a which are contained in b, ignoring case
+func intersectStringsFold(a []string, b []string) []string {
+	result := []string{}
+	for _, value := range a {
+		if containsStringFold(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// subtractStringsFold returns the values of a which are not contained in b, ignoring case
+func subtractStringsFold(a []string, b []string) []string {
+	result := []string{}
+	for _, value := range a {
+		if !containsStringFold(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// unionStringsFold returns the values of a and b without duplicates, ignoring case
+func unionStringsFold(a []string, b []string) []string {
+	result := []string{}
+	for _, value := range append(append([]string{}, a...), b...) {
+		if !containsStringFold(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func containsStringFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
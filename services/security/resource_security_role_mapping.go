@@ -0,0 +1,186 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecurityRoleMapping() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to map an external role, e.g. an LDAP group, to a Nexus role. Nexus maps the external role by its id, so the Nexus role gets the id of the external role and grants its privileges and roles to all members of the external role.",
+
+		Create: resourceSecurityRoleMappingCreate,
+		Read:   resourceSecurityRoleMappingRead,
+		Update: resourceSecurityRoleMappingUpdate,
+		Delete: resourceSecurityRoleMappingDelete,
+		Exists: resourceSecurityRoleMappingExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSecurityRoleMappingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"source": {
+				Description: "The source of the external role, e.g. `LDAP` or `Crowd`",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"external_role_id": {
+				Description: "The id of the external role, e.g. the name of the LDAP group",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"name": {
+				Computed:    true,
+				Description: "The name of the role. Defaults to `external_role_id`",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"description": {
+				Description: "The description of this role.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"privileges": {
+				Description: "The privileges granted to the members of the external role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Set: func(v interface{}) int {
+					return schema.HashString(strings.ToLower(v.(string)))
+				},
+				Type: schema.TypeSet,
+			},
+			"roles": {
+				Description: "The roles granted to the members of the external role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Set: func(v interface{}) int {
+					return schema.HashString(strings.ToLower(v.(string)))
+				},
+				Type: schema.TypeSet,
+			},
+		},
+	}
+}
+
+func getSecurityRoleMappingFromResourceData(d *schema.ResourceData) security.Role {
+	role := security.Role{
+		ID:          d.Get("external_role_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Privileges:  tools.InterfaceSliceToStringSlice(d.Get("privileges").(*schema.Set).List()),
+		Roles:       tools.InterfaceSliceToStringSlice(d.Get("roles").(*schema.Set).List()),
+	}
+	if role.Name == "" {
+		role.Name = role.ID
+	}
+	return role
+}
+
+// checkExternalRole returns an error if the source does not know the role.
+// Nexus would accept the mapping anyway, but it would never match anybody.
+func checkExternalRole(client *api.Client, source string, roleID string) error {
+	roles, err := api.NewSecurityRolesService(client).List(source)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role.ID == roleID {
+			return nil
+		}
+	}
+	return fmt.Errorf("source %q has no role %q", source, roleID)
+}
+
+func resourceSecurityRoleMappingCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	role := getSecurityRoleMappingFromResourceData(d)
+
+	if err := checkExternalRole(client, d.Get("source").(string), role.ID); err != nil {
+		return fmt.Errorf("creating role mapping %q: %w", role.ID, err)
+	}
+	if err := client.Security.Role.Create(role); err != nil {
+		return fmt.Errorf("creating role mapping %q: %w", role.ID, err)
+	}
+
+	d.SetId(role.ID)
+	return resourceSecurityRoleMappingRead(d, m)
+}
+
+func resourceSecurityRoleMappingRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	role, err := client.Security.Role.Get(d.Id())
+	if err != nil {
+		return fmt.Errorf("reading role mapping %q: %w", d.Id(), err)
+	}
+
+	if role == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", role.Description)
+	d.Set("external_role_id", role.ID)
+	d.Set("name", role.Name)
+	d.Set("privileges", tools.StringSliceToInterfaceSlice(role.Privileges))
+	d.Set("roles", tools.StringSliceToInterfaceSlice(role.Roles))
+
+	return nil
+}
+
+func resourceSecurityRoleMappingUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	role := getSecurityRoleMappingFromResourceData(d)
+	if err := client.Security.Role.Update(d.Id(), role); err != nil {
+		return fmt.Errorf("updating role mapping %q: %w", d.Id(), err)
+	}
+
+	return resourceSecurityRoleMappingRead(d, m)
+}
+
+func resourceSecurityRoleMappingDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+
+	if err := client.Security.Role.Delete(d.Id()); err != nil {
+		return fmt.Errorf("deleting role mapping %q: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSecurityRoleMappingExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*api.Client)
+
+	role, err := client.Security.Role.Get(d.Id())
+	return role != nil, err
+}
+
+// resourceSecurityRoleMappingImport imports a role mapping by
+// `<source>/<external_role_id>`, as the Nexus role does not tell which source
+// it maps
+func resourceSecurityRoleMappingImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("importing role mapping %q: expected an id like <source>/<external_role_id>", d.Id())
+	}
+
+	d.SetId(parts[1])
+	d.Set("source", parts[0])
+	return []*schema.ResourceData{d}, nil
+}
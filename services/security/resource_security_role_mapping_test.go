@@ -0,0 +1,94 @@
+package security_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceSecurityRoleMappingLDAPGroup(t *testing.T) {
+	var created *security.Role
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/roles" && r.URL.Query().Get("source") == "LDAP":
+			fmt.Fprint(w, `[{"id":"developers","name":"developers","description":"","source":"LDAP","privileges":[],"roles":[]}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/service/rest/v1/security/roles":
+			created = &security.Role{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/roles/developers" && created != nil:
+			assert.NoError(t, json.NewEncoder(w).Encode(created))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_role_mapping"]
+
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"source":           "LDAP",
+		"external_role_id": "qa",
+		"roles":            []interface{}{"nx-anonymous"},
+	})
+	err := res.Create(resourceData, nexusClient)
+	assert.EqualError(t, err, `creating role mapping "qa": source "LDAP" has no role "qa"`)
+	assert.Nil(t, created)
+
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"source":           "LDAP",
+		"external_role_id": "developers",
+		"privileges":       []interface{}{"nx-repository-view-*-*-read"},
+		"roles":            []interface{}{"nx-anonymous"},
+	})
+	err = res.Create(resourceData, nexusClient)
+	assert.NoError(t, err)
+	if assert.NotNil(t, created) {
+		assert.Equal(t, security.Role{
+			ID:         "developers",
+			Name:       "developers",
+			Privileges: []string{"nx-repository-view-*-*-read"},
+			Roles:      []string{"nx-anonymous"},
+		}, *created)
+	}
+	assert.Equal(t, "developers", resourceData.Id())
+	assert.Equal(t, "developers", resourceData.Get("name"))
+	assert.Equal(t, "LDAP", resourceData.Get("source"))
+}
+
+func TestResourceSecurityRoleMappingImport(t *testing.T) {
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_role_mapping"]
+	importRoleMapping := func(id string) (*schema.ResourceData, error) {
+		resourceData := res.Data(nil)
+		resourceData.SetId(id)
+		imported, err := res.Importer.StateContext(context.Background(), resourceData, nil)
+		if err != nil {
+			return nil, err
+		}
+		return imported[0], nil
+	}
+
+	imported, err := importRoleMapping("LDAP/developers")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "developers", imported.Id())
+		assert.Equal(t, "LDAP", imported.Get("source"))
+	}
+
+	_, err = importRoleMapping("developers")
+	assert.EqualError(t, err, `importing role mapping "developers": expected an id like <source>/<external_role_id>`)
+}
@@ -1,15 +1,24 @@
 package security_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourcesecurityRole(t *testing.T) {
@@ -61,3 +70,135 @@ resource "nexus_security_role" "acceptance" {
 }
 `, role.ID, role.Name, role.Description, strings.Join(role.Privileges, "\",\""), strings.Join(role.Roles, "\",\""))
 }
+
+func TestResourceSecurityRoleNonExclusive(t *testing.T) {
+	serverRole := security.Role{
+		ID:         "ui-role",
+		Name:       "ui-role",
+		Privileges: []string{"nx-ui-a", "nx-ui-b"},
+		Roles:      []string{"nx-anonymous"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/rest/v1/security/roles/ui-role" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if err := json.NewEncoder(w).Encode(serverRole); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&serverRole); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_role"]
+	apply := func(state *terraform.InstanceState, privileges []interface{}) *terraform.InstanceState {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"roleid":     "ui-role",
+			"name":       "ui-role",
+			"exclusive":  false,
+			"privileges": privileges,
+		})
+		diff, err := res.Diff(context.Background(), state, config, nexusClient)
+		assert.NoError(t, err)
+		newState, diags := res.Apply(context.Background(), state, diff, nexusClient)
+		assert.False(t, diags.HasError(), "%v", diags)
+		return newState
+	}
+
+	// Attach a privilege to an imported role
+	state := apply(&terraform.InstanceState{
+		ID: "ui-role",
+		Attributes: map[string]string{
+			"id":           "ui-role",
+			"roleid":       "ui-role",
+			"name":         "ui-role",
+			"exclusive":    "true",
+			"privileges.#": "0",
+			"roles.#":      "0",
+		},
+	}, []interface{}{"nx-tf-a"})
+	assert.ElementsMatch(t, []string{"nx-ui-a", "nx-ui-b", "nx-tf-a"}, serverRole.Privileges)
+	assert.ElementsMatch(t, []string{"nx-anonymous"}, serverRole.Roles)
+	assert.Equal(t, "1", state.Attributes["privileges.#"])
+	assert.Equal(t, "0", state.Attributes["roles.#"])
+
+	// Replace the declared privilege
+	state = apply(state, []interface{}{"nx-tf-b"})
+	assert.ElementsMatch(t, []string{"nx-ui-a", "nx-ui-b", "nx-tf-b"}, serverRole.Privileges)
+	assert.Equal(t, "1", state.Attributes["privileges.#"])
+
+	// Destroy detaches the declared privilege only
+	_, diags := res.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, nexusClient)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.ElementsMatch(t, []string{"nx-ui-a", "nx-ui-b"}, serverRole.Privileges)
+	assert.ElementsMatch(t, []string{"nx-anonymous"}, serverRole.Roles)
+}
+
+func TestResourceSecurityRoleNonExclusiveAdopt(t *testing.T) {
+	serverRole := security.Role{
+		ID:          "ui-role",
+		Name:        "ui-role",
+		Description: "Created in the UI",
+		Privileges:  []string{"nx-ui-a"},
+		Roles:       []string{"nx-anonymous"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/roles":
+			assert.Equal(t, "default", r.URL.Query().Get("source"))
+			json.NewEncoder(w).Encode([]security.Role{serverRole})
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/roles/ui-role":
+			json.NewEncoder(w).Encode(serverRole)
+		case r.Method == http.MethodPut && r.URL.Path == "/service/rest/v1/security/roles/ui-role":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&serverRole))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "role already exists")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_role"]
+	config := map[string]interface{}{
+		"roleid":     "ui-role",
+		"name":       "ui-role",
+		"exclusive":  false,
+		"privileges": []interface{}{"nx-tf-a"},
+	}
+
+	// The existing role is adopted and keeps what was attached in the UI
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+	assert.NoError(t, res.Create(resourceData, nexusClient))
+	assert.Equal(t, "ui-role", resourceData.Id())
+	assert.ElementsMatch(t, []string{"nx-ui-a", "nx-tf-a"}, serverRole.Privileges)
+	assert.ElementsMatch(t, []string{"nx-anonymous"}, serverRole.Roles)
+	assert.ElementsMatch(t, []interface{}{"nx-tf-a"}, resourceData.Get("privileges").(*schema.Set).List())
+
+	// Exclusively managed roles are never adopted
+	config["exclusive"] = true
+	resourceData = schema.TestResourceDataRaw(t, res.Schema, config)
+	assert.ErrorContains(t, res.Create(resourceData, nexusClient), "role already exists")
+}
@@ -1,8 +1,10 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -74,11 +76,11 @@ Use this resource to create a Nexus Security SAML configuration.`,
 }
 
 func resourceSecuritySAMLRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	saml, err := client.Security.SAML.Read()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading SAML configuration: %w", err)
 	}
 
 	if saml == nil {
@@ -90,12 +92,12 @@ func resourceSecuritySAMLRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSecuritySAMLUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	saml := getSecuritySAMLFromResourceData(d)
 
 	if err := client.Security.SAML.Apply(saml); err != nil {
-		return err
+		return fmt.Errorf("applying SAML configuration: %w", err)
 	}
 
 	if err := setSecuritySAMLToResourceData(&saml, d); err != nil {
@@ -106,13 +108,16 @@ func resourceSecuritySAMLUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSecuritySAMLDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
-	return client.Security.SAML.Delete()
+	if err := client.Security.SAML.Delete(); err != nil {
+		return fmt.Errorf("deleting SAML configuration: %w", err)
+	}
+	return nil
 }
 
 func resourceSecuritySAMLExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	saml, _ := client.Security.SAML.Read()
 
@@ -14,7 +14,7 @@ import (
 )
 
 func testAccResourceSecuritySAML() (*security.SAML, error) {
-	dat, err := ioutil.ReadFile("../../../examples/saml-testconfig.xml")
+	dat, err := ioutil.ReadFile("../../examples/saml-testconfig.xml")
 	if err != nil {
 		return nil, err
 	}
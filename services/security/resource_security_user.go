@@ -1,9 +1,11 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
 	"github.com/SimCubeLtd/terraform-provider-nexus/tools"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -51,6 +53,11 @@ func ResourceSecurityUser() *schema.Resource {
 				Required:    true,
 				Sensitive:   true,
 			},
+			"password_rotate_trigger": {
+				Description: "Arbitrary value which sends the password to Nexus again when it changes, even if the password itself did not change. Use it to rotate the password of a service account on a schedule, or to restore a password which was changed outside of Terraform.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"roles": {
 				Description: "The roles which the user has been assigned within Nexus.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -84,11 +91,11 @@ func getSecurityUserFromResourceData(d *schema.ResourceData) security.User {
 }
 
 func resourceSecurityUserCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	user := getSecurityUserFromResourceData(d)
 
 	if err := client.Security.User.Create(user); err != nil {
-		return err
+		return fmt.Errorf("creating user %q: %w", user.UserID, err)
 	}
 
 	d.SetId(user.UserID)
@@ -96,11 +103,11 @@ func resourceSecurityUserCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSecurityUserRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	user, err := client.Security.User.Get(d.Id())
 	if err != nil {
-		return err
+		return fmt.Errorf("reading user %q: %w", d.Id(), err)
 	}
 
 	if user == nil {
@@ -119,29 +126,29 @@ func resourceSecurityUserRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSecurityUserUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
-	if d.HasChange("password") {
+	if d.HasChange("password") || d.HasChange("password_rotate_trigger") {
 		password := d.Get("password").(string)
 		if err := client.Security.User.ChangePassword(d.Id(), password); err != nil {
-			return err
+			return fmt.Errorf("changing password of user %q: %w", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("firstname") || d.HasChange("lastname") || d.HasChange("email") || d.HasChange("status") || d.HasChange("roles") {
 		user := getSecurityUserFromResourceData(d)
 		if err := client.Security.User.Update(d.Id(), user); err != nil {
-			return err
+			return fmt.Errorf("updating user %q: %w", d.Id(), err)
 		}
 	}
 	return resourceSecurityUserRead(d, m)
 }
 
 func resourceSecurityUserDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	if err := client.Security.User.Delete(d.Id()); err != nil {
-		return err
+		return fmt.Errorf("deleting user %q: %w", d.Id(), err)
 	}
 
 	d.SetId("")
@@ -149,7 +156,7 @@ func resourceSecurityUserDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSecurityUserExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	user, err := client.Security.User.Get(d.Id())
 	return user != nil, err
@@ -1,15 +1,23 @@
 package security_test
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/SimCubeLtd/terraform-provider-nexus/acceptance"
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccResourceSecurityUser() security.User {
@@ -78,3 +86,74 @@ resource "nexus_security_user" "acceptance" {
 }
 `, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password, user.Status, strings.Join(user.Roles, "\", \""))
 }
+
+func TestResourceSecurityUserPasswordRotateTrigger(t *testing.T) {
+	passwords := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/service/rest/v1/security/users":
+			fmt.Fprint(w, `[{"userId":"deployer","firstName":"Deployer","lastName":"CI","emailAddress":"deployer@example.com","status":"active","roles":["nx-deploy"]}]`)
+		case r.Method == http.MethodPut && r.URL.Path == "/service/rest/v1/security/users/deployer/change-password":
+			password, _ := io.ReadAll(r.Body)
+			passwords = append(passwords, string(password))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nexusClient := api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+	res := acceptance.TestAccProvider.ResourcesMap["nexus_security_user"]
+	apply := func(state *terraform.InstanceState, password string, trigger string) *terraform.InstanceState {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"userid":                  "deployer",
+			"firstname":               "Deployer",
+			"lastname":                "CI",
+			"email":                   "deployer@example.com",
+			"password":                password,
+			"password_rotate_trigger": trigger,
+			"roles":                   []interface{}{"nx-deploy"},
+		})
+		diff, err := res.Diff(context.Background(), state, config, nexusClient)
+		assert.NoError(t, err)
+		if diff == nil {
+			return state
+		}
+		newState, diags := res.Apply(context.Background(), state, diff, nexusClient)
+		assert.False(t, diags.HasError(), "%v", diags)
+		return newState
+	}
+
+	state := &terraform.InstanceState{
+		ID: "deployer",
+		Attributes: map[string]string{
+			"id":                      "deployer",
+			"userid":                  "deployer",
+			"firstname":               "Deployer",
+			"lastname":                "CI",
+			"email":                   "deployer@example.com",
+			"password":                "secret-1",
+			"password_rotate_trigger": "2022-06",
+			"roles.#":                 "1",
+			"roles.0":                 "nx-deploy",
+			"status":                  "active",
+		},
+	}
+
+	// Nothing changed, the password is not sent
+	state = apply(state, "secret-1", "2022-06")
+	assert.Empty(t, passwords)
+
+	// Bumping the trigger sends the password again
+	state = apply(state, "secret-1", "2022-07")
+	assert.Equal(t, []string{"secret-1"}, passwords)
+	assert.Equal(t, "2022-07", state.Attributes["password_rotate_trigger"])
+
+	apply(state, "secret-1", "2022-07")
+	assert.Equal(t, []string{"secret-1"}, passwords)
+}
@@ -1,12 +1,16 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
 	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
-	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const securityUserTokenID = "globalUserTokenConfiguration"
+
 func ResourceSecurityUserToken() *schema.Resource {
 	return &schema.Resource{
 		Description: `~> PRO Feature
@@ -46,27 +50,27 @@ func getSecurityUserTokenFromResourceData(d *schema.ResourceData) security.UserT
 }
 
 func setSecurityUserTokenToResourceData(token *security.UserTokenConfiguration, d *schema.ResourceData) {
-	d.SetId("golbalUserTokenConfiguration")
+	d.SetId(securityUserTokenID)
 	d.Set("enabled", token.Enabled)
 	d.Set("protect_content", token.ProtectContent)
 }
 
 func resourceSecurityUserTokenRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 	token, err := client.Security.UserTokens.Get()
 	if err != nil {
-		return err
+		return fmt.Errorf("reading user token configuration: %w", err)
 	}
 	setSecurityUserTokenToResourceData(token, d)
 	return nil
 }
 
 func resourceSecurityUserTokenUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := m.(*api.Client)
 
 	token := getSecurityUserTokenFromResourceData(d)
 	if err := client.Security.UserTokens.Configure(token); err != nil {
-		return err
+		return fmt.Errorf("updating user token configuration: %w", err)
 	}
 
 	return resourceSecurityUserTokenRead(d, m)
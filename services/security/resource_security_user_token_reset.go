@@ -0,0 +1,70 @@
+package security
+
+import (
+	"fmt"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecurityUserTokenReset() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to reset the user token of a single user, e.g. when offboarding.
+
+The token is reset once when the resource is created. Destroying the resource does not change anything in Nexus.
+Change ` + "`triggers`" + ` to reset the token again.`,
+
+		Create: resourceSecurityUserTokenResetCreate,
+		Read:   resourceSecurityUserTokenResetRead,
+		Delete: resourceSecurityUserTokenResetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"user_id": {
+				Description: "The id of the user whose token is reset",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will reset the user token again",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+			},
+		},
+	}
+}
+
+func resourceSecurityUserTokenResetCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*api.Client)
+	userID := d.Get("user_id").(string)
+
+	token, err := client.Security.UserTokens.Get()
+	if err != nil {
+		return fmt.Errorf("reading user token configuration: %w", err)
+	}
+	if !token.Enabled {
+		return fmt.Errorf("could not reset user token of user '%s': user tokens are disabled, enable them with the nexus_security_user_token resource", userID)
+	}
+
+	if err := api.NewSecurityUserTokenService(client).Reset(userID); err != nil {
+		return err
+	}
+
+	d.SetId(userID)
+	return nil
+}
+
+func resourceSecurityUserTokenResetRead(d *schema.ResourceData, m interface{}) error {
+	// A reset is a one-shot action, there is no remote state to refresh
+	return nil
+}
+
+func resourceSecurityUserTokenResetDelete(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
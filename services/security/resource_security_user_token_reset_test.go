@@ -0,0 +1,67 @@
+package security_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SimCubeLtd/terraform-provider-nexus/api"
+	"github.com/SimCubeLtd/terraform-provider-nexus/services/security"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSecurityUserTokenResetClient(t *testing.T, enabled bool, requests *[]string) *api.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, fmt.Sprintf("%s %s", r.Method, r.URL.EscapedPath()))
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprintf(w, `{"enabled":%t,"protectContent":false}`, enabled)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return api.NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	}, nil)
+}
+
+func TestResourceSecurityUserTokenResetCreate(t *testing.T) {
+	var requests []string
+	nexusClient := testSecurityUserTokenResetClient(t, true, &requests)
+
+	res := security.ResourceSecurityUserTokenReset()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"user_id": "leaving user",
+	})
+
+	assert.Nil(t, res.Create(resourceData, nexusClient))
+	assert.Equal(t, "leaving user", resourceData.Id())
+	assert.Equal(t, []string{
+		"GET /service/rest/v1/security/user-tokens",
+		"DELETE /service/rest/v1/security/users/leaving%20user/user-token",
+	}, requests)
+}
+
+func TestResourceSecurityUserTokenResetCreateDisabled(t *testing.T) {
+	var requests []string
+	nexusClient := testSecurityUserTokenResetClient(t, false, &requests)
+
+	res := security.ResourceSecurityUserTokenReset()
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"user_id": "someone",
+	})
+
+	err := res.Create(resourceData, nexusClient)
+	assert.EqualError(t, err, "could not reset user token of user 'someone': user tokens are disabled, enable them with the nexus_security_user_token resource")
+	assert.Equal(t, "", resourceData.Id())
+	assert.Equal(t, []string{"GET /service/rest/v1/security/user-tokens"}, requests)
+}
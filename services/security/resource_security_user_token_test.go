@@ -34,6 +34,25 @@ func TestAccResourceSecurityUserToken(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "protect_content", strconv.FormatBool(token.ProtectContent)),
 				),
 			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     "globalUserTokenConfiguration",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The refresh after the import sets the ID, so the misspelled ID
+			// of older versions imports the configuration as well
+			{
+				ResourceName:      resName,
+				ImportStateId:     "golbalUserTokenConfiguration",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:             testAccResourceSecurityUserTokenConfig(token),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
 		},
 	})
 }